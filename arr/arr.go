@@ -2,13 +2,16 @@
 package arr
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/gflydev/utils/num"
 	"github.com/gflydev/utils/str"
+	"math"
 	"math/rand/v2"
 	"net/url"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -44,6 +47,58 @@ func Chunk[T any](array []T, size int) [][]T {
 	return chunks
 }
 
+// Window returns every contiguous subslice of the given size, sliding one element at a time.
+// Each window is a copy, so modifying a window never affects the array or other windows.
+//
+// Parameters:
+//   - array: The input array
+//   - size: The number of elements in each window
+//
+// Returns:
+//   - [][]T: The windows in order, or an empty array if size <= 0 or size > len(array)
+//
+// Example:
+//
+//	Window([]int{1, 2, 3, 4}, 2) -> [][]int{{1, 2}, {2, 3}, {3, 4}}
+//	Window([]int{1, 2, 3}, 3) -> [][]int{{1, 2, 3}}
+//	Window([]int{1, 2}, 3) -> [][]int{}
+func Window[T any](array []T, size int) [][]T {
+	if size <= 0 || size > len(array) {
+		return [][]T{}
+	}
+
+	count := len(array) - size + 1
+	buffer := make([]T, count*size)
+	windows := make([][]T, count)
+	for i := range windows {
+		window := buffer[i*size : (i+1)*size : (i+1)*size]
+		copy(window, array[i:i+size])
+		windows[i] = window
+	}
+
+	return windows
+}
+
+// ChunkBy splits an array into chunks of consecutive elements that produce the same key,
+// starting a new chunk whenever the key changes. Unlike GroupBy, order is preserved and equal
+// keys that are not adjacent end up in separate chunks. Chunks share memory with the array
+// but are capped, so appending to one never overwrites its neighbour.
+//
+// Parameters:
+//   - array: The array to split into chunks
+//   - keyFunc: A function that returns the key of each element
+//
+// Returns:
+//   - [][]T: A new array containing one chunk per run of equal keys
+//
+// Example:
+//
+//	ChunkBy([]int{1, 1, 2, 2, 2, 1}, func(n int) int { return n }) -> [][]int{{1, 1}, {2, 2, 2}, {1}}
+//	ChunkBy([]string{"a", "ab", "b"}, func(s string) byte { return s[0] }) -> [][]string{{"a", "ab"}, {"b"}}
+func ChunkBy[T any, K comparable](array []T, keyFunc func(T) K) [][]T {
+	return GroupConsecutiveBy(array, keyFunc, func(_ K, items []T) []T { return items })
+}
+
 // Compact removes falsey values from an array.
 // In Go, we consider nil, zero values, and empty collections as falsey.
 //
@@ -70,6 +125,25 @@ func Compact[T comparable](array []T) []T {
 	return result
 }
 
+// CompactCount removes falsey values from an array and reports how many were removed.
+// It uses the same definition of falsey as Compact.
+//
+// Parameters:
+//   - array: The array to compact
+//
+// Returns:
+//   - []T: A new array with all falsey values removed
+//   - int: The number of falsey values that were removed
+//
+// Example:
+//
+//	CompactCount([]int{0, 1, 2, 0, 3}) -> []int{1, 2, 3}, 2
+//	CompactCount([]string{"", "a", "", "b"}) -> []string{"a", "b"}, 2
+func CompactCount[T comparable](array []T) ([]T, int) {
+	result := Compact(array)
+	return result, len(array) - len(result)
+}
+
 // Concat concatenates arrays together.
 //
 // Parameters:
@@ -132,6 +206,47 @@ func Difference[T comparable](array []T, others ...[]T) []T {
 	return result
 }
 
+// DifferenceBy returns the elements of the first array whose key, as computed by keyFunc,
+// does not appear among the keys of any of the other arrays. It works with element types
+// that are not comparable, such as structs compared by ID. The order of the first array is
+// preserved, and elements sharing a key are either all kept or all removed.
+//
+// Parameters:
+//   - array: The base array to compare against
+//   - keyFunc: A function that returns the key to compare elements by
+//   - others: Variable number of arrays to compare with the base array
+//
+// Returns:
+//   - []T: A new array containing the elements of the base array whose keys are not in any other array
+//
+// Example:
+//
+//	type User struct {
+//	    ID   int
+//	    Name string
+//	}
+//	all := []User{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}
+//	removed := []User{{2, "Bob"}}
+//	DifferenceBy(all, func(u User) int { return u.ID }, removed)
+//	// Returns: []User{{1, "Alice"}, {3, "Carol"}}
+func DifferenceBy[T any, K comparable](array []T, keyFunc func(T) K, others ...[]T) []T {
+	exclude := make(map[K]struct{})
+	for _, other := range others {
+		for _, v := range other {
+			exclude[keyFunc(v)] = struct{}{}
+		}
+	}
+
+	result := make([]T, 0)
+	for _, v := range array {
+		if _, ok := exclude[keyFunc(v)]; !ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 // Drop creates a slice with n elements dropped from the beginning.
 //
 // Parameters:
@@ -219,6 +334,96 @@ func Fill[T any](array []T, value T, start, end int) []T {
 	return result
 }
 
+// FillFunc fills elements of array with values computed from their index, from start up to,
+// but not including, end. The range is clamped the same way as Fill.
+//
+// Parameters:
+//   - array: The input array
+//   - value: A function that returns the value for a given index
+//   - start: The starting index (inclusive)
+//   - end: The ending index (exclusive)
+//
+// Returns:
+//   - []T: A new array with elements filled with the computed values
+//
+// Example:
+//
+//	FillFunc([]int{0, 0, 0, 0}, func(i int) int { return i + 100 }, 1, 3) -> []int{0, 101, 102, 0}
+//	FillFunc([]string{"a", "b"}, func(i int) string { return strconv.Itoa(i) }, 0, 5) -> []string{"0", "1"}
+func FillFunc[T any](array []T, value func(i int) T, start, end int) []T {
+	length := len(array)
+	if length == 0 {
+		return array
+	}
+
+	if start < 0 {
+		start = 0
+	}
+	if end > length {
+		end = length
+	}
+	if start >= end {
+		return array
+	}
+
+	result := make([]T, length)
+	copy(result, array)
+
+	for i := start; i < end; i++ {
+		result[i] = value(i)
+	}
+
+	return result
+}
+
+// Generate builds a slice of length n where each element is produced by fn from its index.
+//
+// Parameters:
+//   - n: The number of elements to generate
+//   - fn: A function that returns the element for a given index
+//
+// Returns:
+//   - []T: A new slice of n generated elements, or an empty slice if n <= 0
+//
+// Example:
+//
+//	Generate(4, func(i int) int { return i * i }) -> []int{0, 1, 4, 9}
+//	Generate(2, func(i int) string { return fmt.Sprintf("id-%d", i) }) -> []string{"id-0", "id-1"}
+func Generate[T any](n int, fn func(i int) T) []T {
+	if n <= 0 {
+		return []T{}
+	}
+
+	result := make([]T, n)
+	for i := range result {
+		result[i] = fn(i)
+	}
+	return result
+}
+
+// GenerateWhile builds a slice by calling fn with increasing indexes and appending the
+// returned element until fn reports false. The element returned alongside false is discarded.
+//
+// Parameters:
+//   - fn: A function that returns the element for a given index and whether to keep going
+//
+// Returns:
+//   - []T: A new slice of the generated elements
+//
+// Example:
+//
+//	GenerateWhile(func(i int) (int, bool) { return 1 << i, 1<<i <= 16 }) -> []int{1, 2, 4, 8, 16}
+func GenerateWhile[T any](fn func(i int) (T, bool)) []T {
+	result := []T{}
+	for i := 0; ; i++ {
+		value, ok := fn(i)
+		if !ok {
+			return result
+		}
+		result = append(result, value)
+	}
+}
+
 // First returns the first element of an array.
 //
 // Parameters:
@@ -279,6 +484,141 @@ func Flatten[T any](array [][]T) []T {
 	return result
 }
 
+// StreamFlatten calls fn for each element of the nested array in order, without
+// allocating a combined slice. It is the callback-based alternative to Flatten.
+//
+// Parameters:
+//   - array: The nested array to walk
+//   - fn: The function called with each element
+//
+// Example:
+//
+//	sum := 0
+//	StreamFlatten([][]int{{1, 2}, {3}, {4, 5}}, func(n int) { sum += n })
+//	// sum = 15
+func StreamFlatten[T any](array [][]T, fn func(T)) {
+	for _, inner := range array {
+		for _, item := range inner {
+			fn(item)
+		}
+	}
+}
+
+// FlattenDeep recursively flattens nested slices and arrays into a single []any.
+// Elements are visited depth-first, left to right, so the result keeps the order in which
+// leaves appear when the value is printed. Values that are not slices or arrays (including
+// strings and maps) are appended as-is. A slice that contains itself, directly or through
+// nested slices, is not descended into again, so self-referential data does not recurse forever.
+//
+// Parameters:
+//   - value: The value to flatten, typically a slice of nested slices
+//
+// Returns:
+//   - []any: The flattened leaf values; nil yields an empty slice and a non-slice value
+//     yields a slice containing just that value
+//
+// Example:
+//
+//	FlattenDeep([]any{1, []any{2, []int{3, 4}}, [2]string{"a", "b"}}) -> []any{1, 2, 3, 4, "a", "b"}
+//	FlattenDeep([][]int{{1}, {2, 3}}) -> []any{1, 2, 3}
+//	FlattenDeep(42) -> []any{42}
+//	FlattenDeep(nil) -> []any{}
+func FlattenDeep(value any) []any {
+	result := []any{}
+	if value == nil {
+		return result
+	}
+
+	return flattenDeep(reflect.ValueOf(value), result, map[flattenVisit]bool{})
+}
+
+// flattenVisit identifies a slice by its backing array and length for cycle detection.
+type flattenVisit struct {
+	ptr    uintptr
+	length int
+}
+
+// flattenDeep appends the leaves of v to result. The visiting map holds the slices on the
+// current path, so a slice reappearing inside itself is skipped while repeated siblings are not.
+func flattenDeep(v reflect.Value, result []any, visiting map[flattenVisit]bool) []any {
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		key := flattenVisit{v.Pointer(), v.Len()}
+		if v.Len() > 0 && visiting[key] {
+			return result
+		}
+		visiting[key] = true
+		defer delete(visiting, key)
+	case reflect.Array:
+	default:
+		if !v.IsValid() {
+			return append(result, nil)
+		}
+		return append(result, v.Interface())
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		result = flattenDeep(v.Index(i), result, visiting)
+	}
+	return result
+}
+
+// FlattenMapValues concatenates all value slices of a map into a single array.
+// This reverses a grouping such as the one produced by GroupBy. The order of the
+// groups follows map iteration order and is not guaranteed; use FlattenMapValuesSorted
+// for a deterministic result.
+//
+// Parameters:
+//   - m: The map of slices to flatten
+//
+// Returns:
+//   - []V: A new array containing all values from all slices
+//
+// Example:
+//
+//	FlattenMapValues(map[string][]int{"odd": {1, 3}, "even": {2}}) -> []int{1, 3, 2} (group order may vary)
+func FlattenMapValues[K comparable, V any](m map[K][]V) []V {
+	var totalLen int
+	for _, values := range m {
+		totalLen += len(values)
+	}
+
+	result := make([]V, 0, totalLen)
+	for _, values := range m {
+		result = append(result, values...)
+	}
+
+	return result
+}
+
+// FlattenMapValuesSorted concatenates all value slices of a map into a single array,
+// visiting the groups in ascending key order.
+//
+// Parameters:
+//   - m: The map of slices to flatten
+//
+// Returns:
+//   - []V: A new array containing all values, grouped by ascending key
+//
+// Example:
+//
+//	FlattenMapValuesSorted(map[string][]int{"odd": {1, 3}, "even": {2}}) -> []int{2, 1, 3}
+func FlattenMapValuesSorted[K int | int8 | int16 | int32 | int64 | float32 | float64 | string, V any](m map[K][]V) []V {
+	keys := MapKeys(m)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	result := make([]V, 0)
+	for _, key := range keys {
+		result = append(result, m[key]...)
+	}
+
+	return result
+}
+
 // Includes checks if a value is in the array.
 //
 // Parameters:
@@ -301,6 +641,31 @@ func Includes[T comparable](array []T, value T) bool {
 	return false
 }
 
+// NewMembership builds a set from the array once and returns a predicate that
+// reports whether a value is in the array.
+// Use it instead of Includes when checking many values against the same large array:
+// building the set is O(n), but every lookup afterwards is O(1).
+//
+// Parameters:
+//   - array: The array to build the membership set from
+//
+// Returns:
+//   - func(T) bool: A predicate that returns true if the value is found in the array
+//
+// Example:
+//
+//	isAllowed := NewMembership([]string{"read", "write"})
+//	isAllowed("write") -> true
+//	isAllowed("delete") -> false
+func NewMembership[T comparable](array []T) func(T) bool {
+	set := SliceToSet(array)
+
+	return func(value T) bool {
+		_, ok := set[value]
+		return ok
+	}
+}
+
 // IndexOf returns the index of the first occurrence of value in array.
 //
 // Parameters:
@@ -368,6 +733,9 @@ func Initial[T any](array []T) []T {
 }
 
 // Intersection returns an array of unique values that are included in all given arrays.
+// The candidates are taken from the smallest array, so the result is ordered by first
+// appearance in that array (the first one when several arrays share the smallest length).
+// The scan stops early as soon as no candidates remain.
 //
 // Parameters:
 //   - arrays: Variable number of arrays to find common elements from
@@ -379,6 +747,7 @@ func Initial[T any](array []T) []T {
 //
 //	Intersection([]int{1, 2, 3}, []int{2, 3, 4}) -> []int{2, 3}
 //	Intersection([]string{"a", "b", "c"}, []string{"b", "c", "d"}, []string{"b", "e"}) -> []string{"b"}
+//	Intersection([]int{1, 2, 3, 4}, []int{4, 2}) -> []int{4, 2}
 //	Intersection([]int{1, 2}, []int{3, 4}) -> []int{}
 func Intersection[T comparable](arrays ...[]T) []T {
 	if len(arrays) == 0 {
@@ -388,23 +757,83 @@ func Intersection[T comparable](arrays ...[]T) []T {
 		return Uniq(arrays[0])
 	}
 
-	// Count occurrences of each value
-	counts := make(map[T]int)
-	for _, arr := range arrays {
-		// Use a set to count each value only once per array
-		seen := make(map[T]bool)
+	// Build the candidates from the smallest array
+	smallest := 0
+	for i, arr := range arrays {
+		if len(arr) < len(arrays[smallest]) {
+			smallest = i
+		}
+	}
+	result := append(make([]T, 0, len(arrays[smallest])), Uniq(arrays[smallest])...)
+
+	for i, arr := range arrays {
+		if i == smallest {
+			continue
+		}
+		if len(result) == 0 {
+			break
+		}
+
+		// Keep only candidates that also appear in this array
+		candidates := SliceToSet(result)
+		found := make(map[T]struct{}, len(candidates))
 		for _, v := range arr {
-			if !seen[v] {
-				counts[v]++
-				seen[v] = true
+			if _, ok := candidates[v]; ok {
+				found[v] = struct{}{}
+			}
+		}
+
+		kept := result[:0]
+		for _, v := range result {
+			if _, ok := found[v]; ok {
+				kept = append(kept, v)
+			}
+		}
+		result = kept
+	}
+
+	return result
+}
+
+// IntersectionBy returns the elements of the first array whose key, as computed by keyFunc,
+// appears among the keys of every other array. It works with element types that are not
+// comparable, such as structs compared by ID. Unlike Intersection, the original elements of
+// the first array are returned in their original order, including duplicates: elements
+// sharing a key are either all kept or all removed.
+//
+// Parameters:
+//   - array: The base array whose elements are returned
+//   - keyFunc: A function that returns the key to compare elements by
+//   - others: Variable number of arrays whose keys must all contain the element's key
+//
+// Returns:
+//   - []T: A new array containing the elements of the base array whose keys are in all other arrays
+//
+// Example:
+//
+//	type User struct {
+//	    ID   int
+//	    Name string
+//	}
+//	local := []User{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}
+//	remote := []User{{3, "Carol (remote)"}, {1, "Alice (remote)"}}
+//	IntersectionBy(local, func(u User) int { return u.ID }, remote)
+//	// Returns: []User{{1, "Alice"}, {3, "Carol"}}
+func IntersectionBy[T any, K comparable](array []T, keyFunc func(T) K, others ...[]T) []T {
+	// Count in how many of the other arrays each key appears
+	seen := make(map[K]int)
+	for i, other := range others {
+		for _, v := range other {
+			key := keyFunc(v)
+			if seen[key] == i {
+				seen[key] = i + 1
 			}
 		}
 	}
 
-	// Keep values that appear in all arrays
 	result := make([]T, 0)
-	for v, count := range counts {
-		if count == len(arrays) {
+	for _, v := range array {
+		if seen[keyFunc(v)] == len(others) {
 			result = append(result, v)
 		}
 	}
@@ -451,6 +880,29 @@ func Join[T any](array []T, separator string) string {
 	return result
 }
 
+// JoinEach joins each inner slice of strings into a single string.
+// Empty or nil inner slices produce an empty string, so the result always has
+// one entry per inner slice.
+//
+// Parameters:
+//   - nested: The slices of strings to join
+//   - sep: The separator to place between elements of each inner slice
+//
+// Returns:
+//   - []string: A new array containing one joined string per inner slice
+//
+// Example:
+//
+//	JoinEach([][]string{{"a", "b"}, {"c"}}, " ") -> []string{"a b", "c"}
+//	JoinEach([][]string{{"x", "y"}, {}}, ",") -> []string{"x,y", ""}
+func JoinEach(nested [][]string, sep string) []string {
+	result := make([]string, len(nested))
+	for i, inner := range nested {
+		result[i] = strings.Join(inner, sep)
+	}
+	return result
+}
+
 // Last returns the last element of an array.
 //
 // Parameters:
@@ -554,30 +1006,73 @@ func Pull[T comparable](array []T, values ...T) []T {
 	return result
 }
 
-// Pull removes and returns an item from the array by key
-/*func Pull[T any](array []T, index int) (T, []T) {
-	if index < 0 || index >= len(array) {
-		var zero T
-		return zero, array
-	}
-
-	item := array[index]
-	result := append(array[:index], array[index+1:]...)
-	return item, result
-}*/
-
-// Reverse reverses the order of elements in array.
+// ReplaceValue replaces all occurrences of oldValue in array with newValue.
 //
 // Parameters:
-//   - slice: The input array to reverse
+//   - array: The input array
+//   - oldValue: The value to replace
+//   - newValue: The value to replace it with
 //
 // Returns:
-//   - []T: A new array with elements in reverse order
+//   - []T: A new array with all occurrences of oldValue replaced
 //
 // Example:
 //
-//	Reverse([]int{1, 2, 3}) -> []int{3, 2, 1}
-//	Reverse([]string{"a", "b", "c"}) -> []string{"c", "b", "a"}
+//	ReplaceValue([]int{1, 2, 1, 3}, 1, 9) -> []int{9, 2, 9, 3}
+//	ReplaceValue([]string{"a", "b"}, "c", "d") -> []string{"a", "b"}
+func ReplaceValue[T comparable](array []T, oldValue, newValue T) []T {
+	return ReplaceWhere(array, func(v T) bool { return v == oldValue }, newValue)
+}
+
+// ReplaceWhere replaces all elements that satisfy the predicate with newValue.
+//
+// Parameters:
+//   - array: The input array
+//   - predicate: The function to test each element
+//   - newValue: The value to replace matching elements with
+//
+// Returns:
+//   - []T: A new array with all matching elements replaced
+//
+// Example:
+//
+//	ReplaceWhere([]int{-1, 2, -3}, func(n int) bool { return n < 0 }, 0) -> []int{0, 2, 0}
+func ReplaceWhere[T any](array []T, predicate func(T) bool, newValue T) []T {
+	result := make([]T, len(array))
+	for i, v := range array {
+		if predicate(v) {
+			result[i] = newValue
+		} else {
+			result[i] = v
+		}
+	}
+	return result
+}
+
+// Pull removes and returns an item from the array by key
+/*func Pull[T any](array []T, index int) (T, []T) {
+	if index < 0 || index >= len(array) {
+		var zero T
+		return zero, array
+	}
+
+	item := array[index]
+	result := append(array[:index], array[index+1:]...)
+	return item, result
+}*/
+
+// Reverse reverses the order of elements in array.
+//
+// Parameters:
+//   - slice: The input array to reverse
+//
+// Returns:
+//   - []T: A new array with elements in reverse order
+//
+// Example:
+//
+//	Reverse([]int{1, 2, 3}) -> []int{3, 2, 1}
+//	Reverse([]string{"a", "b", "c"}) -> []string{"c", "b", "a"}
 //	Reverse([]int{1}) -> []int{1}
 func Reverse[T any](slice []T) []T {
 	result := make([]T, len(slice))
@@ -587,6 +1082,73 @@ func Reverse[T any](slice []T) []T {
 	return result
 }
 
+// Rotate returns a new slice with the elements rotated left by n positions, so the element
+// at index n becomes the first. A negative n rotates right, and n is taken modulo the length.
+//
+// Parameters:
+//   - slice: The input array to rotate
+//   - n: The number of positions to rotate left
+//
+// Returns:
+//   - []T: A new array with the elements rotated
+//
+// Example:
+//
+//	Rotate([]int{1, 2, 3, 4, 5}, 2) -> []int{3, 4, 5, 1, 2}
+//	Rotate([]int{1, 2, 3, 4, 5}, -1) -> []int{5, 1, 2, 3, 4}
+//	Rotate([]int{1, 2, 3}, 3) -> []int{1, 2, 3}
+func Rotate[T any](slice []T, n int) []T {
+	result := make([]T, len(slice))
+	if len(slice) == 0 {
+		return result
+	}
+
+	n = rotationOffset(len(slice), n)
+	copy(result, slice[n:])
+	copy(result[len(slice)-n:], slice[:n])
+	return result
+}
+
+// RotateInPlace rotates the elements of slice left by n positions, modifying slice itself.
+// A negative n rotates right, and n is taken modulo the length. It uses three reversals,
+// so it needs no extra memory; prefer it over Rotate for large slices such as ring buffers.
+//
+// Parameters:
+//   - slice: The array to rotate in place
+//   - n: The number of positions to rotate left
+//
+// Example:
+//
+//	ring := []int{1, 2, 3, 4, 5}
+//	RotateInPlace(ring, 2)
+//	// ring: []int{3, 4, 5, 1, 2}
+func RotateInPlace[T any](slice []T, n int) {
+	if len(slice) == 0 {
+		return
+	}
+
+	n = rotationOffset(len(slice), n)
+	reverseInPlace(slice[:n])
+	reverseInPlace(slice[n:])
+	reverseInPlace(slice)
+}
+
+// rotationOffset normalizes a left rotation of n positions to the range [0, length).
+func rotationOffset(length, n int) int {
+	n %= length
+	if n < 0 {
+		n += length
+	}
+	return n
+}
+
+// reverseInPlace reverses the order of the elements of slice.
+func reverseInPlace[T any](slice []T) {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
 // Shuffle returns a new slice with elements in random order.
 //
 // Parameters:
@@ -658,6 +1220,71 @@ func Random[T any](slice []T, n int) []T {
 	return shuffled[:n]
 }
 
+// RandomSubset returns a random subset of the array where each element is included
+// independently with the given probability (Bernoulli sampling). The original order is preserved.
+//
+// Parameters:
+//   - array: The input array to sample from
+//   - probability: The probability, between 0 and 1, of including each element
+//
+// Returns:
+//   - []T: A new array containing the sampled elements
+//
+// Notes:
+//   - If probability <= 0, an empty slice is returned
+//   - If probability >= 1, a copy of the entire array is returned
+//   - Use RandomSubsetWithSeed for reproducible results
+//
+// Example:
+//
+//	RandomSubset([]int{1, 2, 3, 4, 5}, 0.5) -> [1, 4, 5]
+//	RandomSubset([]int{1, 2, 3}, 0) -> []
+//	RandomSubset([]int{1, 2, 3}, 1) -> [1, 2, 3]
+func RandomSubset[T any](array []T, probability float64) []T {
+	return randomSubset(array, probability, rand.Float64)
+}
+
+// RandomSubsetWithSeed works like RandomSubset but draws from a generator seeded with seed,
+// so the same seed always selects the same elements.
+//
+// Parameters:
+//   - array: The input array to sample from
+//   - probability: The probability, between 0 and 1, of including each element
+//   - seed: The seed for the random number generator
+//
+// Returns:
+//   - []T: A new array containing the sampled elements
+//
+// Example:
+//
+//	RandomSubsetWithSeed([]int{1, 2, 3, 4, 5}, 0.5, 42) -> the same subset on every call
+func RandomSubsetWithSeed[T any](array []T, probability float64, seed uint64) []T {
+	r := rand.New(rand.NewPCG(seed, seed))
+	return randomSubset(array, probability, r.Float64)
+}
+
+// randomSubset includes each element of array when next() returns a value below probability.
+func randomSubset[T any](array []T, probability float64, next func() float64) []T {
+	if probability <= 0 {
+		return []T{}
+	}
+
+	if probability >= 1 {
+		result := make([]T, len(array))
+		copy(result, array)
+		return result
+	}
+
+	result := make([]T, 0)
+	for _, v := range array {
+		if next() < probability {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
 // Random returns a random value from an array
 /*func Random[T any](array []T) (T, bool) {
 	if len(array) == 0 {
@@ -754,6 +1381,105 @@ func SortedIndex[T int | int8 | int16 | int32 | int64 | float32 | float64](array
 	return len(array)
 }
 
+// SortedInsert inserts value into a sorted array at the index returned by SortedIndex,
+// so the result stays sorted. The input array is not modified.
+//
+// Parameters:
+//   - array: The sorted input array
+//   - value: The value to insert
+//
+// Returns:
+//   - []T: A new sorted array containing the value
+//
+// Example:
+//
+//	SortedInsert([]int{1, 3, 5, 7}, 4) -> []int{1, 3, 4, 5, 7}
+//	SortedInsert([]int{1, 3}, 0) -> []int{0, 1, 3}
+//	SortedInsert([]int{}, 1) -> []int{1}
+func SortedInsert[T int | int8 | int16 | int32 | int64 | float32 | float64](array []T, value T) []T {
+	return insertAt(array, SortedIndex(array, value), value)
+}
+
+// SortedInsertBy inserts value into an array sorted by less, so the result stays sorted.
+// The value is placed before the first element that is not less than it. The input array is not modified.
+//
+// Parameters:
+//   - array: The input array, sorted according to less
+//   - value: The value to insert
+//   - less: The comparison function that defines the sort order
+//
+// Returns:
+//   - []T: A new sorted array containing the value
+//
+// Example:
+//
+//	SortedInsertBy([]string{"c", "a"}, "b", func(i, j string) bool { return i > j }) -> []string{"c", "b", "a"}
+func SortedInsertBy[T any](array []T, value T, less func(i, j T) bool) []T {
+	index := sort.Search(len(array), func(i int) bool {
+		return !less(array[i], value)
+	})
+	return insertAt(array, index, value)
+}
+
+// insertAt returns a new array with value inserted at index.
+func insertAt[T any](array []T, index int, value T) []T {
+	result := make([]T, 0, len(array)+1)
+	result = append(result, array[:index]...)
+	result = append(result, value)
+	return append(result, array[index:]...)
+}
+
+// MergeSorted merges two sorted arrays into a single sorted array in O(n+m).
+// When elements are equal, those from a come before those from b.
+//
+// Parameters:
+//   - a: The first sorted array
+//   - b: The second sorted array
+//
+// Returns:
+//   - []T: A new sorted array containing all elements of a and b
+//
+// Example:
+//
+//	MergeSorted([]int{1, 3, 5}, []int{2, 4, 6}) -> []int{1, 2, 3, 4, 5, 6}
+//	MergeSorted([]int{1, 2, 2}, []int{2, 3}) -> []int{1, 2, 2, 2, 3}
+//	MergeSorted([]int{}, []int{1}) -> []int{1}
+func MergeSorted[T int | int8 | int16 | int32 | int64 | float32 | float64 | string](a, b []T) []T {
+	return MergeSortedBy(a, b, func(i, j T) bool { return i < j })
+}
+
+// MergeSortedBy merges two arrays sorted by less into a single sorted array in O(n+m).
+// When elements are equal, those from a come before those from b.
+//
+// Parameters:
+//   - a: The first array, sorted according to less
+//   - b: The second array, sorted according to less
+//   - less: The comparison function that defines the sort order
+//
+// Returns:
+//   - []T: A new array containing all elements of a and b, sorted according to less
+//
+// Example:
+//
+//	MergeSortedBy([]int{5, 3, 1}, []int{4, 2}, func(i, j int) bool { return i > j }) -> []int{5, 4, 3, 2, 1}
+func MergeSortedBy[T any](a, b []T, less func(i, j T) bool) []T {
+	result := make([]T, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			result = append(result, b[j])
+			j++
+		} else {
+			result = append(result, a[i])
+			i++
+		}
+	}
+
+	result = append(result, a[i:]...)
+	return append(result, b[j:]...)
+}
+
 // Tail returns all but the first element of array.
 //
 // Parameters:
@@ -823,6 +1549,61 @@ func TakeRight[T any](array []T, n int) []T {
 	return array[length-n:]
 }
 
+// CycleTake repeats the elements of array cyclically until the result has exactly n elements.
+// The last cycle is truncated if n is not a multiple of the array length.
+//
+// Parameters:
+//   - array: The input array
+//   - n: The number of elements in the result
+//
+// Returns:
+//   - []T: A new array of n elements, or an empty array if array is empty or n <= 0
+//
+// Example:
+//
+//	CycleTake([]string{"red", "green", "blue"}, 5) -> []string{"red", "green", "blue", "red", "green"}
+//	CycleTake([]int{1, 2, 3}, 2) -> []int{1, 2}
+//	CycleTake([]int{}, 3) -> []int{}
+func CycleTake[T any](array []T, n int) []T {
+	if len(array) == 0 || n <= 0 {
+		return []T{}
+	}
+
+	result := make([]T, n)
+	for i := range result {
+		result[i] = array[i%len(array)]
+	}
+	return result
+}
+
+// SplitAt splits an array into the elements before index and the elements from index on.
+// The index is clamped to the bounds of the array, and both halves are new slices that do
+// not share memory with the input.
+//
+// Parameters:
+//   - array: The input array
+//   - index: The position to split at
+//
+// Returns:
+//   - left: A new array with the elements before index
+//   - right: A new array with the elements from index to the end
+//
+// Example:
+//
+//	SplitAt([]int{1, 2, 3, 4, 5}, 2) -> []int{1, 2}, []int{3, 4, 5}
+//	SplitAt([]int{1, 2, 3}, 10) -> []int{1, 2, 3}, []int{}
+//	SplitAt([]int{1, 2, 3}, -1) -> []int{}, []int{1, 2, 3}
+func SplitAt[T any](array []T, index int) (left, right []T) {
+	index = min(max(index, 0), len(array))
+
+	left = make([]T, index)
+	copy(left, array[:index])
+	right = make([]T, len(array)-index)
+	copy(right, array[index:])
+
+	return left, right
+}
+
 // Union creates an array of unique values from all given arrays.
 //
 // Parameters:
@@ -890,6 +1671,88 @@ func Uniq[T comparable](array []T) []T {
 	return result
 }
 
+// DedupSorted removes consecutive duplicate values from a sorted array in a single linear pass.
+// Unlike Uniq it needs no map, but the array must be sorted (or at least have equal values
+// grouped together); otherwise duplicates that are not adjacent are kept.
+//
+// Parameters:
+//   - array: The sorted input array
+//
+// Returns:
+//   - []T: A new array with duplicate elements removed
+//
+// Example:
+//
+//	DedupSorted([]int{1, 1, 2, 3, 3, 3}) -> []int{1, 2, 3}
+//	DedupSorted([]string{"a", "a", "b"}) -> []string{"a", "b"}
+//	DedupSorted([]int{1, 2, 1}) -> []int{1, 2, 1} (unsorted input)
+func DedupSorted[T comparable](array []T) []T {
+	result := make([]T, 0, len(array))
+	for i, v := range array {
+		if i == 0 || v != array[i-1] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// FindDuplicates returns the values that appear more than once in an array.
+// Each duplicate is listed once, in the order in which its second occurrence is found.
+//
+// Parameters:
+//   - array: The input array
+//
+// Returns:
+//   - []T: A new array containing each duplicated value once
+//
+// Example:
+//
+//	FindDuplicates([]int{1, 2, 3, 2, 1, 2}) -> []int{2, 1}
+//	FindDuplicates([]string{"a", "b"}) -> []string{}
+func FindDuplicates[T comparable](array []T) []T {
+	return FindDuplicatesBy(array, func(v T) T { return v })
+}
+
+// FindDuplicatesBy returns the elements whose key, as returned by keyFunc, appears more than once.
+// For each duplicated key the first element with that key is listed once, in the order
+// in which the key's second occurrence is found.
+//
+// Parameters:
+//   - array: The input array
+//   - keyFunc: The function that returns the key to compare elements by
+//
+// Returns:
+//   - []T: A new array containing the first element of each duplicated key
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int
+//	    Email string
+//	}
+//	users := []User{{1, "a@x.io"}, {2, "b@x.io"}, {3, "a@x.io"}}
+//	FindDuplicatesBy(users, func(u User) string { return u.Email }) -> []User{{1, "a@x.io"}}
+func FindDuplicatesBy[T any, K comparable](array []T, keyFunc func(T) K) []T {
+	first := make(map[K]int)
+	reported := make(map[K]bool)
+	result := make([]T, 0)
+
+	for i, v := range array {
+		key := keyFunc(v)
+		index, seen := first[key]
+		if !seen {
+			first[key] = i
+			continue
+		}
+		if !reported[key] {
+			reported[key] = true
+			result = append(result, array[index])
+		}
+	}
+
+	return result
+}
+
 // Without creates an array excluding all given values.
 //
 // Parameters:
@@ -950,6 +1813,105 @@ func Zip[T any](arrays ...[]T) [][]T {
 	return result
 }
 
+// ZipPair combines two arrays of possibly different element types into pairs.
+// Unlike Zip, the inputs do not need to share a type. The result is as long as the shorter input.
+//
+// Parameters:
+//   - a: The array providing the First field of each pair
+//   - b: The array providing the Second field of each pair
+//
+// Returns:
+//   - A new slice of structs, each containing a First and Second field
+//
+// Example:
+//
+//	pairs := ZipPair([]string{"a", "b", "c"}, []int{1, 2})
+//	// pairs is a slice of struct{First string; Second int}:
+//	// [{First: "a", Second: 1}, {First: "b", Second: 2}]
+func ZipPair[A any, B any](a []A, b []B) []struct {
+	First  A
+	Second B
+} {
+	n := min(len(a), len(b))
+	result := make([]struct {
+		First  A
+		Second B
+	}, n)
+
+	for i := 0; i < n; i++ {
+		result[i].First = a[i]
+		result[i].Second = b[i]
+	}
+
+	return result
+}
+
+// Unzip2 splits a slice of pairs back into two arrays.
+// This is the inverse operation of ZipPair.
+//
+// Parameters:
+//   - pairs: A slice of structs, each containing a First and Second field
+//
+// Returns:
+//   - []A: A new array of the First fields
+//   - []B: A new array of the Second fields
+//
+// Example:
+//
+//	keys, values := Unzip2(ZipPair([]string{"a", "b"}, []int{1, 2}))
+//	// keys is []string{"a", "b"}, values is []int{1, 2}
+func Unzip2[A any, B any](pairs []struct {
+	First  A
+	Second B
+}) ([]A, []B) {
+	firsts := make([]A, len(pairs))
+	seconds := make([]B, len(pairs))
+
+	for i, pair := range pairs {
+		firsts[i] = pair.First
+		seconds[i] = pair.Second
+	}
+
+	return firsts, seconds
+}
+
+// Transpose flips the rows and columns of a matrix.
+// Ragged input is padded rather than truncated: the result has as many rows as the
+// longest input row, and missing cells are filled with the zero value of T.
+//
+// Parameters:
+//   - matrix: The matrix to transpose, as a slice of rows
+//
+// Returns:
+//   - [][]T: A new matrix where result[j][i] == matrix[i][j]
+//
+// Example:
+//
+//	Transpose([][]int{{1, 2, 3}, {4, 5, 6}}) -> [][]int{{1, 4}, {2, 5}, {3, 6}}
+//	Transpose([][]int{{1, 2}, {3}}) -> [][]int{{1, 3}, {2, 0}} (ragged input is zero-padded)
+//	Transpose([][]int{}) -> [][]int{}
+func Transpose[T any](matrix [][]T) [][]T {
+	// Find the length of the longest row
+	maxLen := 0
+	for _, row := range matrix {
+		if len(row) > maxLen {
+			maxLen = len(row)
+		}
+	}
+
+	result := make([][]T, maxLen)
+	for j := range result {
+		result[j] = make([]T, len(matrix))
+		for i, row := range matrix {
+			if j < len(row) {
+				result[j][i] = row[j]
+			}
+		}
+	}
+
+	return result
+}
+
 // SortBy sorts an array by the results of running each element through the iteratee function.
 // It returns a new sorted array without modifying the original.
 //
@@ -1012,7 +1974,197 @@ func Contains[T comparable](slice []T, element T) bool {
 			return true
 		}
 	}
-	return false
+	return false
+}
+
+// ContainsBy checks if any element of the array satisfies the predicate.
+// Unlike Contains, it works with element types that are not comparable.
+//
+// Parameters:
+//   - array: The input array to search in
+//   - pred: A function that returns true for the element being looked for
+//
+// Returns:
+//   - true if at least one element satisfies the predicate, false otherwise
+//
+// Example:
+//
+//	type User struct {
+//	    ID    int
+//	    Roles []string
+//	}
+//	users := []User{{ID: 1}, {ID: 2, Roles: []string{"admin"}}}
+//	ContainsBy(users, func(u User) bool { return len(u.Roles) > 0 }) // Returns true
+func ContainsBy[T any](array []T, pred func(T) bool) bool {
+	for _, item := range array {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAll checks if a slice contains all of the given values.
+// It returns true when no values are given.
+//
+// Parameters:
+//   - slice: The input slice to search in
+//   - values: The values to search for
+//
+// Returns:
+//   - true if every value is found in the slice, false otherwise
+//
+// Example:
+//
+//	ContainsAll([]string{"read", "write", "admin"}, "read", "write") // Returns true
+//	ContainsAll([]string{"read"}, "read", "write") // Returns false
+//	ContainsAll([]string{"read"}) // Returns true
+func ContainsAll[T comparable](slice []T, values ...T) bool {
+	if len(values) == 0 {
+		return true
+	}
+
+	set := SliceToSet(slice)
+	for _, value := range values {
+		if _, ok := set[value]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsAny checks if a slice contains at least one of the given values.
+// It returns false when no values are given.
+//
+// Parameters:
+//   - slice: The input slice to search in
+//   - values: The values to search for
+//
+// Returns:
+//   - true if any value is found in the slice, false otherwise
+//
+// Example:
+//
+//	ContainsAny([]int{1, 2, 3}, 5, 3) // Returns true
+//	ContainsAny([]int{1, 2, 3}, 4, 5) // Returns false
+//	ContainsAny([]int{1, 2, 3}) // Returns false
+func ContainsAny[T comparable](slice []T, values ...T) bool {
+	if len(values) == 0 {
+		return false
+	}
+
+	set := SliceToSet(values)
+	for _, item := range slice {
+		if _, ok := set[item]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSubset checks if every element of sub is also in super.
+// Both slices are treated as sets, so order and duplicates are ignored.
+// An empty sub is a subset of any slice.
+//
+// Parameters:
+//   - sub: The candidate subset
+//   - super: The candidate superset
+//
+// Returns:
+//   - true if sub is a subset of super, false otherwise
+//
+// Example:
+//
+//	IsSubset([]string{"editor"}, []string{"viewer", "editor"}) // Returns true
+//	IsSubset([]string{"admin", "editor"}, []string{"editor"}) // Returns false
+//	IsSubset([]int{1, 1}, []int{1}) // Returns true
+func IsSubset[T comparable](sub, super []T) bool {
+	return ContainsAll(super, sub...)
+}
+
+// IsSuperset checks if super contains every element of sub.
+// Both slices are treated as sets, so order and duplicates are ignored.
+// This is the inverse operation of IsSubset.
+//
+// Parameters:
+//   - super: The candidate superset
+//   - sub: The candidate subset
+//
+// Returns:
+//   - true if super is a superset of sub, false otherwise
+//
+// Example:
+//
+//	IsSuperset([]string{"viewer", "editor"}, []string{"editor"}) // Returns true
+//	IsSuperset([]int{1, 2}, []int{2, 3}) // Returns false
+func IsSuperset[T comparable](super, sub []T) bool {
+	return IsSubset(sub, super)
+}
+
+// EqualUnordered checks if two arrays contain the same elements with the same
+// number of occurrences, in any order (multiset equality).
+//
+// Parameters:
+//   - a: The first array
+//   - b: The second array
+//
+// Returns:
+//   - true if both arrays contain the same elements the same number of times, false otherwise
+//
+// Example:
+//
+//	EqualUnordered([]string{"go", "web"}, []string{"web", "go"}) // Returns true
+//	EqualUnordered([]int{1, 1, 2}, []int{1, 2, 2}) // Returns false
+//	EqualUnordered([]int{}, []int{}) // Returns true
+func EqualUnordered[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+
+	return true
+}
+
+// EqualBy checks if two arrays have the same length and pairwise equal elements, in order,
+// using eq to compare elements. Use it for element types that are not comparable or need a
+// custom notion of equality; use EqualUnordered when order does not matter.
+//
+// Parameters:
+//   - a: The first array
+//   - b: The second array
+//   - eq: A function that reports whether two elements are equal
+//
+// Returns:
+//   - true if both arrays are equal element by element, false otherwise
+//
+// Example:
+//
+//	EqualBy([]string{"Go", "WEB"}, []string{"go", "web"}, strings.EqualFold) // Returns true
+//	EqualBy([]float64{1.0, 2.0}, []float64{1.0001, 2.0}, func(x, y float64) bool {
+//	    return math.Abs(x-y) < 0.01
+//	}) // Returns true
+//	EqualBy([]int{1, 2}, []int{2, 1}, func(x, y int) bool { return x == y }) // Returns false
+func EqualBy[T any](a, b []T, eq func(x, y T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 // Filter returns a new slice containing only the elements that satisfy the predicate function.
@@ -1057,6 +2209,34 @@ func Filter[T any](slice []T, predicate func(T) bool) []T {
 	return result
 }
 
+// Partition splits an array into the elements that satisfy the predicate and those that
+// do not, in a single pass. Both results are non-nil and the input is not modified.
+//
+// Parameters:
+//   - array: The input array
+//   - predicate: A function that returns true for elements that belong in matched
+//
+// Returns:
+//   - matched: The elements for which the predicate returned true, in order
+//   - rest: The elements for which the predicate returned false, in order
+//
+// Example:
+//
+//	even, odd := Partition([]int{1, 2, 3, 4, 5}, func(n int) bool { return n%2 == 0 })
+//	// even = [2, 4], odd = [1, 3, 5]
+func Partition[T any](array []T, predicate func(T) bool) (matched []T, rest []T) {
+	matched = make([]T, 0)
+	rest = make([]T, 0)
+	for _, item := range array {
+		if predicate(item) {
+			matched = append(matched, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	return matched, rest
+}
+
 // Map applies a function to each element in a slice and returns a new slice with the results.
 // It transforms each element from type T to type R using the provided mapping function.
 //
@@ -1096,6 +2276,33 @@ func Map[T any, R any](slice []T, mapFunc func(T) R) []R {
 	return result
 }
 
+// WalkSlice calls visit for each element of the array in order, stopping as soon as
+// visit returns false. It behaves like a for-range loop with break, but can be used
+// where only a callback is accepted.
+//
+// Parameters:
+//   - array: The input array
+//   - visit: A function called with each index and element; return false to stop
+//
+// Example:
+//
+//	// Print elements until the first negative number
+//	WalkSlice([]int{1, 2, -1, 3}, func(i int, v int) bool {
+//	    if v < 0 {
+//	        return false
+//	    }
+//	    fmt.Println(i, v)
+//	    return true
+//	})
+//	// Prints "0 1" and "1 2"
+func WalkSlice[T any](array []T, visit func(i int, v T) bool) {
+	for i, v := range array {
+		if !visit(i, v) {
+			return
+		}
+	}
+}
+
 // Find returns the first element in the slice that satisfies the predicate function
 // and a boolean indicating whether such an element was found.
 //
@@ -1189,6 +2396,46 @@ func FindLastIndex[T any](array []T, predicate func(T) bool) int {
 	return -1
 }
 
+// FindAllIndexes returns the indexes of all elements that satisfy the predicate.
+//
+// Parameters:
+//   - array: The array to search in
+//   - predicate: The function to test each element
+//
+// Returns:
+//   - []int: The indexes of all matching elements, in ascending order
+//
+// Example:
+//
+//	FindAllIndexes([]int{1, 2, 3, 4}, func(n int) bool { return n%2 == 0 }) -> []int{1, 3}
+//	FindAllIndexes([]int{1, 3}, func(n int) bool { return n%2 == 0 }) -> []int{}
+func FindAllIndexes[T any](array []T, predicate func(T) bool) []int {
+	result := make([]int, 0)
+	for i, v := range array {
+		if predicate(v) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// IndexesOf returns the indexes of all occurrences of value in array.
+//
+// Parameters:
+//   - array: The array to search in
+//   - value: The value to search for
+//
+// Returns:
+//   - []int: The indexes of all occurrences of the value, in ascending order
+//
+// Example:
+//
+//	IndexesOf([]string{"a", "b", "a"}, "a") -> []int{0, 2}
+//	IndexesOf([]int{1, 2, 3}, 4) -> []int{}
+func IndexesOf[T comparable](array []T, value T) []int {
+	return FindAllIndexes(array, func(v T) bool { return v == value })
+}
+
 // Unique returns a new slice with duplicate elements removed.
 // It preserves the order of elements, keeping the first occurrence of each element.
 //
@@ -1388,6 +2635,84 @@ func GroupBy[T any, K comparable](slice []T, keyFunc func(T) K) map[K][]T {
 	return result
 }
 
+// GroupBy2 groups the elements of an array by two keys, producing a nested map where the
+// first key selects the outer group and the second key the inner one. Elements keep their
+// input order within each leaf slice.
+//
+// Parameters:
+//   - array: The input array
+//   - k1: A function that returns the outer key of an element
+//   - k2: A function that returns the inner key of an element
+//
+// Returns:
+//   - A nested map from outer key to inner key to the elements sharing both keys
+//
+// Example:
+//
+//	type Sale struct {
+//	    Region  string
+//	    Product string
+//	    Amount  int
+//	}
+//	sales := []Sale{{"eu", "book", 10}, {"us", "pen", 2}, {"eu", "book", 5}, {"eu", "pen", 1}}
+//	byRegionAndProduct := GroupBy2(sales,
+//	    func(s Sale) string { return s.Region },
+//	    func(s Sale) string { return s.Product },
+//	)
+//	// Returns map["eu":map["book":[{eu book 10} {eu book 5}] "pen":[{eu pen 1}]]
+//	//             "us":map["pen":[{us pen 2}]]]
+func GroupBy2[T any, K1, K2 comparable](array []T, k1 func(T) K1, k2 func(T) K2) map[K1]map[K2][]T {
+	result := make(map[K1]map[K2][]T)
+	for _, item := range array {
+		outer := k1(item)
+		inner, ok := result[outer]
+		if !ok {
+			inner = make(map[K2][]T)
+			result[outer] = inner
+		}
+		key := k2(item)
+		inner[key] = append(inner[key], item)
+	}
+	return result
+}
+
+// GroupConsecutiveBy splits an array into runs of consecutive elements that share
+// the same key and aggregates each run into a single result. Unlike GroupBy, equal
+// keys that are not adjacent start a new run.
+//
+// Parameters:
+//   - array: The input array
+//   - keyFunc: A function that returns the key used to detect run boundaries
+//   - agg: A function that reduces a run to a result, given its key and elements
+//
+// Returns:
+//   - []R: One aggregated result per consecutive run, in input order
+//
+// Example:
+//
+//	lines := []string{"ok", "ok", "ok", "fail", "ok"}
+//	GroupConsecutiveBy(lines, func(s string) string { return s }, func(key string, items []string) string {
+//	    return fmt.Sprintf("%s (x%d)", key, len(items))
+//	})
+//	// Returns []string{"ok (x3)", "fail (x1)", "ok (x1)"}
+func GroupConsecutiveBy[T any, K comparable, R any](array []T, keyFunc func(T) K, agg func(key K, items []T) R) []R {
+	result := []R{}
+	if len(array) == 0 {
+		return result
+	}
+
+	start := 0
+	current := keyFunc(array[0])
+	for i := 1; i < len(array); i++ {
+		key := keyFunc(array[i])
+		if key != current {
+			result = append(result, agg(current, array[start:i:i]))
+			start, current = i, key
+		}
+	}
+	return append(result, agg(current, array[start:len(array):len(array)]))
+}
+
 // Accessible checks if the given value can be accessed as an array, slice, or map.
 // It returns true if the value is an array, slice, or map, and false otherwise.
 //
@@ -1701,6 +3026,58 @@ func Get(array map[string]any, key string, defaultValue any) any {
 	return defaultValue
 }
 
+// NormalizeJSONNumbers walks a decoded JSON structure and converts whole numbers to int.
+// encoding/json decodes every number as float64 (or json.Number with UseNumber), so a value
+// such as 30 no longer equals the int 30. After normalization Get, Has and reflect.DeepEqual
+// behave predictably. Fractional numbers are returned as float64 and all other values are
+// returned unchanged. Maps and slices are copied, the input is not modified.
+//
+// Parameters:
+//   - value: The decoded JSON value, typically a map[string]any or []any
+//
+// Returns:
+//   - any: The value with whole numbers converted to int
+//
+// Example:
+//
+//	var data map[string]any
+//	json.Unmarshal([]byte(`{"age": 30, "score": 9.5, "tags": [1, 2]}`), &data)
+//
+//	NormalizeJSONNumbers(data)
+//	// Returns: map[string]any{"age": 30, "score": 9.5, "tags": []any{1, 2}}
+func NormalizeJSONNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = NormalizeJSONNumbers(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = NormalizeJSONNumbers(item)
+		}
+		return result
+	case float64:
+		// Only convert whole numbers that fit in an int
+		if v == math.Trunc(v) && v >= math.MinInt && v < -math.MinInt {
+			return int(v)
+		}
+		return v
+	case json.Number:
+		if i, err := strconv.ParseInt(string(v), 10, 0); err == nil {
+			return int(i)
+		}
+		if f, err := v.Float64(); err == nil {
+			return NormalizeJSONNumbers(f)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
 // IsAssoc determines if a value is an associative array/map (has string keys).
 // It checks if the value is a map with string keys.
 //
@@ -1834,6 +3211,32 @@ func KeyBy[T any, K comparable](array []T, keyFunc func(T) K) map[K]T {
 	return result
 }
 
+// AssociateWith creates a map from an array of keys, using the result of the value function
+// as the value for each key. It is the inverse of KeyBy: the elements become the keys.
+// Duplicate keys are computed only once.
+//
+// Parameters:
+//   - keys: The input slice whose elements become the map keys
+//   - valueFn: A function that computes the value for each key
+//
+// Returns:
+//   - A map from each distinct element of keys to its computed value
+//
+// Example:
+//
+//	AssociateWith([]string{"apple", "kiwi"}, func(s string) int { return len(s) })
+//	// Returns map["apple":5 "kiwi":4]
+func AssociateWith[K comparable, V any](keys []K, valueFn func(K) V) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, key := range keys {
+		if _, ok := result[key]; ok {
+			continue
+		}
+		result[key] = valueFn(key)
+	}
+	return result
+}
+
 // LastOrDefault returns the last element in the array, or a default value if the array is empty.
 // It safely handles empty arrays by returning the provided default value.
 //
@@ -2202,6 +3605,56 @@ func isNil(value any) bool {
 		kind == reflect.Chan) && v.IsNil()
 }
 
+// ToPointers returns a slice of pointers to copies of the elements of s.
+// The pointers refer to a new backing array, so writing through them does not modify s.
+//
+// Parameters:
+//   - s: The source slice
+//
+// Returns:
+//   - []*T: A new slice with a pointer to a copy of each element
+//
+// Example:
+//
+//	ptrs := ToPointers([]int{1, 2})
+//	// *ptrs[0] == 1, *ptrs[1] == 2
+func ToPointers[T any](s []T) []*T {
+	values := make([]T, len(s))
+	copy(values, s)
+
+	result := make([]*T, len(s))
+	for i := range values {
+		result[i] = &values[i]
+	}
+	return result
+}
+
+// Deref returns a slice of the values pointed to by the elements of s.
+// Nil pointers are replaced with def.
+//
+// Parameters:
+//   - s: The slice of pointers
+//   - def: The value to use for nil pointers
+//
+// Returns:
+//   - []T: A new slice with the dereferenced values
+//
+// Example:
+//
+//	one, two := 1, 2
+//	Deref([]*int{&one, nil, &two}, -1) // Returns []int{1, -1, 2}
+func Deref[T any](s []*T, def T) []T {
+	result := make([]T, len(s))
+	for i, ptr := range s {
+		if ptr == nil {
+			result[i] = def
+		} else {
+			result[i] = *ptr
+		}
+	}
+	return result
+}
+
 // Wrap ensures a value is contained in a slice. If the value is already a slice or array,
 // it converts it to []any. Otherwise, it creates a new slice containing the value.
 //
@@ -2245,6 +3698,52 @@ func Wrap(value any) []any {
 	return []any{value}
 }
 
+// ToAnySlice converts a typed slice to a []any, for interop with reflection-based APIs.
+//
+// Parameters:
+//   - slice: The slice to convert
+//
+// Returns:
+//   - []any: A new slice containing the same elements as any values
+//
+// Example:
+//
+//	ToAnySlice([]int{1, 2, 3}) -> []any{1, 2, 3}
+//	ToAnySlice([]string{}) -> []any{}
+func ToAnySlice[T any](slice []T) []any {
+	result := make([]any, len(slice))
+	for i, v := range slice {
+		result[i] = v
+	}
+	return result
+}
+
+// FromAnySlice converts a []any to a typed slice by type-asserting each element.
+// This is the inverse operation of ToAnySlice.
+//
+// Parameters:
+//   - slice: The slice to convert
+//
+// Returns:
+//   - []T: A new slice containing the elements as T, or nil if any element is not a T
+//   - bool: True if every element is a T, false otherwise
+//
+// Example:
+//
+//	FromAnySlice[int]([]any{1, 2, 3}) -> []int{1, 2, 3}, true
+//	FromAnySlice[int]([]any{1, "2", 3}) -> nil, false
+func FromAnySlice[T any](slice []any) ([]T, bool) {
+	result := make([]T, len(slice))
+	for i, v := range slice {
+		typed, ok := v.(T)
+		if !ok {
+			return nil, false
+		}
+		result[i] = typed
+	}
+	return result, true
+}
+
 // Set sets a value within a nested map using "dot" notation.
 //
 // Parameters:
@@ -2807,6 +4306,46 @@ func MapMerge[K comparable, V any](maps ...map[K]V) map[K]V {
 	return result
 }
 
+// MapMergeFunc combines multiple maps into a single new map, calling resolve to decide the
+// value of every key that appears in more than one map.
+//
+// Parameters:
+//   - resolve: A function that receives the key, the value merged so far (a) and the incoming
+//     value (b), and returns the value to keep
+//   - maps: Variable number of maps to merge, in order
+//
+// Returns:
+//   - A new map containing all keys from the input maps
+//
+// Notes:
+//   - resolve is only called on actual collisions; keys seen once are copied verbatim
+//   - If resolve is nil, later values overwrite earlier ones, as in MapMerge
+//
+// Example:
+//
+//	shard1 := map[string]int{"a": 1, "b": 2}
+//	shard2 := map[string]int{"b": 3, "c": 4}
+//	result := arr.MapMergeFunc(func(key string, a, b int) int { return a + b }, shard1, shard2)
+//	// result: {"a": 1, "b": 5, "c": 4}
+func MapMergeFunc[K comparable, V any](resolve func(key K, a, b V) V, maps ...map[K]V) map[K]V {
+	if resolve == nil {
+		return MapMerge(maps...)
+	}
+
+	result := make(map[K]V)
+	for _, m := range maps {
+		for k, v := range m {
+			if existing, ok := result[k]; ok {
+				result[k] = resolve(k, existing, v)
+			} else {
+				result[k] = v
+			}
+		}
+	}
+
+	return result
+}
+
 // MapKeys extracts all keys from a map into a slice.
 //
 // Parameters:
@@ -2971,6 +4510,40 @@ func MapInvertMap[K comparable, V comparable](m map[K]V) map[V]K {
 	return result
 }
 
+// ReverseGroups inverts a grouping: every value in m[k] becomes a key of the result whose
+// slice contains k. A map of tag to users thus becomes a map of user to tags.
+//
+// Parameters:
+//   - m: The grouping to invert
+//
+// Returns:
+//   - A new map from each value to the keys whose groups contain it
+//
+// Notes:
+//   - A key is listed once per value, even if the value repeats within its group
+//   - Keys with empty groups do not appear in the result
+//   - Since map iteration order is random, the order of keys within each slice is unspecified
+//
+// Example:
+//
+//	usersByTag := map[string][]string{"go": {"ann", "bob"}, "rust": {"bob"}}
+//	tagsByUser := arr.ReverseGroups(usersByTag)
+//	// tagsByUser: {"ann": ["go"], "bob": ["go", "rust"]} (order of tags may vary)
+func ReverseGroups[K comparable, V comparable](m map[K][]V) map[V][]K {
+	result := make(map[V][]K)
+	for k, group := range m {
+		seen := make(map[V]struct{}, len(group))
+		for _, v := range group {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			result[v] = append(result[v], k)
+		}
+	}
+	return result
+}
+
 // MapGetOrDefault safely retrieves a value from a map, returning a default value if the key doesn't exist.
 //
 // Parameters:
@@ -3187,6 +4760,91 @@ func MapDiffMaps[K comparable, V comparable](m1, m2 map[K]V) (added, removed, ch
 	return added, removed, changed
 }
 
+// MapBatch splits the entries of a map into batches of up to size entries and calls fn
+// with each batch, stopping at the first error. Batches are filled in map iteration
+// order, so which entries end up together is not guaranteed.
+//
+// Parameters:
+//   - m: The map to split into batches
+//   - size: The maximum number of entries per batch; if size <= 0 the whole map is one batch
+//   - fn: The function called with each batch
+//
+// Returns:
+//   - error: The first error returned by fn, or nil if every batch succeeded
+//
+// Example:
+//
+//	users := map[int]string{1: "Alice", 2: "Bob", 3: "Carol"}
+//	err := arr.MapBatch(users, 2, func(batch map[int]string) error {
+//	    return db.BulkUpsert(batch) // Called twice: with 2 entries, then with 1
+//	})
+func MapBatch[K comparable, V any](m map[K]V, size int, fn func(batch map[K]V) error) error {
+	if size <= 0 {
+		size = len(m)
+	}
+
+	batch := make(map[K]V, min(size, len(m)))
+	for key, value := range m {
+		batch[key] = value
+		if len(batch) == size {
+			if err := fn(batch); err != nil {
+				return err
+			}
+			batch = make(map[K]V, min(size, len(m)))
+		}
+	}
+
+	if len(batch) > 0 {
+		return fn(batch)
+	}
+
+	return nil
+}
+
+// SumByKey reduces a map of float64 slices to the total of each slice.
+// Keys with an empty slice map to 0.
+//
+// Parameters:
+//   - m: The map of values to sum, e.g. amounts grouped by user
+//
+// Returns:
+//   - map[K]float64: A new map with the total for each key
+//
+// Example:
+//
+//	amounts := map[string][]float64{"alice": {10, 5.5}, "bob": {3}, "carol": {}}
+//	arr.SumByKey(amounts)
+//	// Returns: map[string]float64{"alice": 15.5, "bob": 3, "carol": 0}
+func SumByKey[K comparable](m map[K][]float64) map[K]float64 {
+	return SumByKeyOf(m)
+}
+
+// SumByKeyOf reduces a map of numeric slices to the total of each slice.
+// It is the generic version of SumByKey and works with any integer or float type.
+//
+// Parameters:
+//   - m: The map of values to sum
+//
+// Returns:
+//   - map[K]V: A new map with the total for each key
+//
+// Example:
+//
+//	counts := map[string][]int{"errors": {2, 3}, "warnings": {1}}
+//	arr.SumByKeyOf(counts)
+//	// Returns: map[string]int{"errors": 5, "warnings": 1}
+func SumByKeyOf[K comparable, V float64 | int | int64 | float32 | int32 | int16 | int8 | uint | uint64 | uint32 | uint16 | uint8](m map[K][]V) map[K]V {
+	result := make(map[K]V, len(m))
+	for key, values := range m {
+		var sum V
+		for _, v := range values {
+			sum += v
+		}
+		result[key] = sum
+	}
+	return result
+}
+
 // SetContains checks if a set (implemented as map[T]struct{}) contains a specific element.
 //
 // Parameters:
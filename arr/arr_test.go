@@ -1,9 +1,14 @@
 package arr
 
 import (
+	"encoding/json"
+	"errors"
+	"math"
 	"net/url"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -27,6 +32,67 @@ func TestChunk(t *testing.T) {
 	}
 }
 
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{[]int{1, 2, 3, 4}, 1, [][]int{{1}, {2}, {3}, {4}}},
+		{[]int{1, 2, 3}, 3, [][]int{{1, 2, 3}}}, // size == len
+		{[]int{1, 2}, 3, [][]int{}},
+		{[]int{1, 2}, 0, [][]int{}},
+		{[]int{1, 2}, -1, [][]int{}},
+		{[]int{}, 1, [][]int{}},
+	}
+
+	for _, test := range tests {
+		result := Window(test.input, test.size)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Window(%v, %d) = %v, expected %v", test.input, test.size, result, test.expected)
+		}
+	}
+
+	// Windows are copies
+	input := []int{1, 2, 3}
+	windows := Window(input, 2)
+	windows[0][1] = 9
+	_ = append(windows[0], 8)
+	if !reflect.DeepEqual(input, []int{1, 2, 3}) || !reflect.DeepEqual(windows[1], []int{2, 3}) {
+		t.Errorf("Window aliases memory: input %v, windows %v", input, windows)
+	}
+}
+
+func TestChunkBy(t *testing.T) {
+	identity := func(n int) int { return n }
+
+	tests := []struct {
+		input    []int
+		expected [][]int
+	}{
+		{[]int{1, 1, 2, 2, 2, 1}, [][]int{{1, 1}, {2, 2, 2}, {1}}},
+		{[]int{1, 2, 3}, [][]int{{1}, {2}, {3}}},
+		{[]int{4, 4}, [][]int{{4, 4}}},
+		{[]int{}, [][]int{}},
+	}
+
+	for _, test := range tests {
+		result := ChunkBy(test.input, identity)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ChunkBy(%v, identity) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	// Appending to a chunk does not overwrite the next one
+	input := []int{1, 1, 2}
+	chunks := ChunkBy(input, identity)
+	_ = append(chunks[0], 9)
+	if !reflect.DeepEqual(input, []int{1, 1, 2}) {
+		t.Errorf("ChunkBy chunks are not capped: input became %v", input)
+	}
+}
+
 func TestCompact(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -46,6 +112,26 @@ func TestCompact(t *testing.T) {
 	}
 }
 
+func TestCompactCount(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+		removed  int
+	}{
+		{[]int{0, 1, 2, 0, 3}, []int{1, 2, 3}, 2},
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{0, 0, 0}, []int{}, 3},
+		{[]int{}, []int{}, 0},
+	}
+
+	for _, test := range tests {
+		result, removed := CompactCount(test.input)
+		if !reflect.DeepEqual(result, test.expected) || removed != test.removed {
+			t.Errorf("CompactCount(%v) = %v, %d, expected %v, %d", test.input, result, removed, test.expected, test.removed)
+		}
+	}
+}
+
 func TestConcat(t *testing.T) {
 	tests := []struct {
 		inputs   [][]int
@@ -85,6 +171,34 @@ func TestDifference(t *testing.T) {
 	}
 }
 
+func TestDifferenceBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	byID := func(u user) int { return u.ID }
+
+	tests := []struct {
+		array    []user
+		others   [][]user
+		expected []user
+	}{
+		{[]user{{1, "a"}, {2, "b"}, {3, "c"}}, [][]user{{{2, "x"}}}, []user{{1, "a"}, {3, "c"}}},
+		{[]user{{1, "a"}, {2, "b"}, {3, "c"}}, [][]user{{{2, "x"}}, {{1, "y"}}}, []user{{3, "c"}}},
+		{[]user{{3, "c"}, {1, "a"}, {3, "d"}}, [][]user{{{1, "x"}}}, []user{{3, "c"}, {3, "d"}}}, // Order and duplicates kept
+		{[]user{{1, "a"}, {1, "b"}, {2, "c"}}, [][]user{{{1, "x"}}}, []user{{2, "c"}}},           // Duplicates removed together
+		{[]user{{1, "a"}}, nil, []user{{1, "a"}}},
+		{[]user{}, [][]user{{{1, "x"}}}, []user{}},
+	}
+
+	for _, test := range tests {
+		result := DifferenceBy(test.array, byID, test.others...)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("DifferenceBy(%v, byID, %v) = %v, expected %v", test.array, test.others, result, test.expected)
+		}
+	}
+}
+
 func TestDrop(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -180,6 +294,25 @@ func TestIncludes(t *testing.T) {
 	}
 }
 
+func TestNewMembership(t *testing.T) {
+	tests := []struct {
+		input    []int
+		value    int
+		expected bool
+	}{
+		{[]int{1, 2, 3}, 2, true},
+		{[]int{1, 2, 3}, 4, false},
+		{[]int{}, 1, false},
+	}
+
+	for _, test := range tests {
+		result := NewMembership(test.input)(test.value)
+		if result != test.expected {
+			t.Errorf("NewMembership(%v)(%d) = %v, expected %v", test.input, test.value, result, test.expected)
+		}
+	}
+}
+
 func TestLast(t *testing.T) {
 	tests := []struct {
 		input      []int
@@ -220,6 +353,78 @@ func TestFill(t *testing.T) {
 	}
 }
 
+func TestFillFunc(t *testing.T) {
+	offset := func(i int) int { return i + 100 }
+
+	tests := []struct {
+		input    []int
+		start    int
+		end      int
+		expected []int
+	}{
+		{[]int{0, 0, 0, 0}, 1, 3, []int{0, 101, 102, 0}},
+		{[]int{0, 0, 0, 0}, 0, 4, []int{100, 101, 102, 103}},
+		{[]int{0, 0, 0, 0}, -1, 2, []int{100, 101, 0, 0}}, // Negative start is treated as 0
+		{[]int{0, 0, 0, 0}, 2, 10, []int{0, 0, 102, 103}},
+		{[]int{1, 2, 3}, 2, 1, []int{1, 2, 3}},
+		{[]int{}, 0, 2, []int{}},
+	}
+
+	for _, test := range tests {
+		result := FillFunc(test.input, offset, test.start, test.end)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FillFunc(%v, offset, %d, %d) = %v, expected %v", test.input, test.start, test.end, result, test.expected)
+		}
+	}
+
+	// The input is not modified
+	input := []int{0, 0, 0}
+	FillFunc(input, offset, 0, 3)
+	if !reflect.DeepEqual(input, []int{0, 0, 0}) {
+		t.Errorf("FillFunc modified its input: %v", input)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	square := func(i int) int { return i * i }
+
+	tests := []struct {
+		n        int
+		expected []int
+	}{
+		{4, []int{0, 1, 4, 9}},
+		{1, []int{0}},
+		{0, []int{}},
+		{-2, []int{}},
+	}
+
+	for _, test := range tests {
+		result := Generate(test.n, square)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Generate(%d, square) = %v, expected %v", test.n, result, test.expected)
+		}
+	}
+}
+
+func TestGenerateWhile(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       func(i int) (int, bool)
+		expected []int
+	}{
+		{"powers of two", func(i int) (int, bool) { return 1 << i, 1<<i <= 16 }, []int{1, 2, 4, 8, 16}},
+		{"first three", func(i int) (int, bool) { return i, i < 3 }, []int{0, 1, 2}},
+		{"none", func(i int) (int, bool) { return i, false }, []int{}},
+	}
+
+	for _, test := range tests {
+		result := GenerateWhile(test.fn)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("GenerateWhile(%s) = %v, expected %v", test.name, result, test.expected)
+		}
+	}
+}
+
 func TestFindLastIndex(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -238,6 +443,45 @@ func TestFindLastIndex(t *testing.T) {
 	}
 }
 
+func TestFindAllIndexes(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{1, 2, 3, 4}, []int{1, 3}},
+		{[]int{2, 4, 6}, []int{0, 1, 2}},
+		{[]int{1, 3}, []int{}},
+		{[]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := FindAllIndexes(test.input, func(n int) bool { return n%2 == 0 })
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FindAllIndexes(%v, isEven) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestIndexesOf(t *testing.T) {
+	tests := []struct {
+		input    []string
+		value    string
+		expected []int
+	}{
+		{[]string{"a", "b", "a"}, "a", []int{0, 2}},
+		{[]string{"a", "b", "a"}, "b", []int{1}},
+		{[]string{"a", "b"}, "c", []int{}},
+		{[]string{}, "a", []int{}},
+	}
+
+	for _, test := range tests {
+		result := IndexesOf(test.input, test.value)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("IndexesOf(%v, %q) = %v, expected %v", test.input, test.value, result, test.expected)
+		}
+	}
+}
+
 func TestFlatten(t *testing.T) {
 	tests := []struct {
 		input    [][]int
@@ -256,6 +500,99 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestStreamFlatten(t *testing.T) {
+	tests := []struct {
+		input    [][]int
+		expected []int
+	}{
+		{[][]int{{1, 2}, {3}, {4, 5}}, []int{1, 2, 3, 4, 5}},
+		{[][]int{{}, {1}, nil}, []int{1}},
+		{[][]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		visited := []int{}
+		StreamFlatten(test.input, func(n int) { visited = append(visited, n) })
+		if !reflect.DeepEqual(visited, test.expected) {
+			t.Errorf("StreamFlatten(%v) visited %v, expected %v", test.input, visited, test.expected)
+		}
+	}
+}
+
+func TestFlattenDeep(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    any
+		expected []any
+	}{
+		{"nested any", []any{1, []any{2, []any{3, []any{4}}}, 5}, []any{1, 2, 3, 4, 5}},
+		{"mixed slice types", []any{1, []int{2, 3}, [2]string{"a", "b"}}, []any{1, 2, 3, "a", "b"}},
+		{"typed nested", [][]int{{1}, {2, 3}}, []any{1, 2, 3}},
+		{"empty nested", []any{[]any{}, []int(nil), 1}, []any{1}},
+		{"leaves kept as-is", []any{"ab", map[string]int{"x": 1}, nil}, []any{"ab", map[string]int{"x": 1}, nil}},
+		{"scalar", 42, []any{42}},
+		{"nil", nil, []any{}},
+	}
+
+	for _, test := range tests {
+		result := FlattenDeep(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FlattenDeep(%s) = %v, expected %v", test.name, result, test.expected)
+		}
+	}
+
+	// Repeated siblings are flattened every time they appear
+	shared := []any{1, 2}
+	if result := FlattenDeep([]any{shared, shared}); !reflect.DeepEqual(result, []any{1, 2, 1, 2}) {
+		t.Errorf("FlattenDeep(shared siblings) = %v, expected %v", result, []any{1, 2, 1, 2})
+	}
+
+	// Self-referential slices terminate
+	cyclic := []any{1, nil, 2}
+	cyclic[1] = cyclic
+	if result := FlattenDeep(cyclic); !reflect.DeepEqual(result, []any{1, 2}) {
+		t.Errorf("FlattenDeep(cyclic) = %v, expected %v", result, []any{1, 2})
+	}
+}
+
+func TestFlattenMapValues(t *testing.T) {
+	tests := []struct {
+		input    map[string][]int
+		expected []int
+	}{
+		{map[string][]int{"odd": {1, 3}, "even": {2, 4}}, []int{1, 2, 3, 4}},
+		{map[string][]int{"a": {}, "b": {5}}, []int{5}},
+		{map[string][]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := FlattenMapValues(test.input)
+		// Group order follows map iteration, so sort for deterministic comparison
+		sort.Ints(result)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FlattenMapValues(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestFlattenMapValuesSorted(t *testing.T) {
+	tests := []struct {
+		input    map[string][]int
+		expected []int
+	}{
+		{map[string][]int{"odd": {1, 3}, "even": {2, 4}}, []int{2, 4, 1, 3}},
+		{map[string][]int{"c": {3}, "a": {1, 1}, "b": {}}, []int{1, 1, 3}},
+		{map[string][]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := FlattenMapValuesSorted(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FlattenMapValuesSorted(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestIndexOf(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -320,6 +657,9 @@ func TestIntersection(t *testing.T) {
 		{[][]int{{1, 2, 3}, {2, 3, 4}}, []int{2, 3}},
 		{[][]int{{1, 2}, {2, 3}, {2, 4}}, []int{2}},
 		{[][]int{{1, 2}, {3, 4}}, []int{}},
+		{[][]int{{1, 2, 3, 4}, {4, 2}}, []int{4, 2}},
+		{[][]int{{1, 2, 3}, {}, {1, 2}}, []int{}},
+		{[][]int{{1}, {1, 1}}, []int{1}},
 		{[][]int{}, []int{}},
 	}
 
@@ -331,6 +671,35 @@ func TestIntersection(t *testing.T) {
 	}
 }
 
+func TestIntersectionBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	byID := func(u user) int { return u.ID }
+
+	tests := []struct {
+		array    []user
+		others   [][]user
+		expected []user
+	}{
+		{[]user{{1, "a"}, {2, "b"}, {3, "c"}}, [][]user{{{3, "x"}, {1, "y"}}}, []user{{1, "a"}, {3, "c"}}},
+		{[]user{{1, "a"}, {2, "b"}, {3, "c"}}, [][]user{{{1, "x"}, {2, "x"}}, {{2, "y"}, {3, "y"}}}, []user{{2, "b"}}},
+		{[]user{{2, "a"}, {1, "b"}, {2, "c"}}, [][]user{{{2, "x"}}}, []user{{2, "a"}, {2, "c"}}}, // Duplicates kept together
+		{[]user{{1, "a"}}, [][]user{{{1, "x"}, {1, "y"}}, {{2, "z"}}}, []user{}},                 // Repeated keys count once per array
+		{[]user{{1, "a"}, {2, "b"}}, [][]user{{}}, []user{}},
+		{[]user{{1, "a"}}, nil, []user{{1, "a"}}},
+		{[]user{}, [][]user{{{1, "x"}}}, []user{}},
+	}
+
+	for _, test := range tests {
+		result := IntersectionBy(test.array, byID, test.others...)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("IntersectionBy(%v, byID, %v) = %v, expected %v", test.array, test.others, result, test.expected)
+		}
+	}
+}
+
 func TestJoin(t *testing.T) {
 	tests := []struct {
 		input     []int
@@ -356,6 +725,25 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinEach(t *testing.T) {
+	tests := []struct {
+		input    [][]string
+		sep      string
+		expected []string
+	}{
+		{[][]string{{"a", "b"}, {"c"}}, " ", []string{"a b", "c"}},
+		{[][]string{{"x", "y"}, {}, nil}, ",", []string{"x,y", "", ""}},
+		{[][]string{}, ",", []string{}},
+	}
+
+	for _, test := range tests {
+		result := JoinEach(test.input, test.sep)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("JoinEach(%v, %q) = %v, expected %v", test.input, test.sep, result, test.expected)
+		}
+	}
+}
+
 func TestNth(t *testing.T) {
 	tests := []struct {
 		input      []int
@@ -435,6 +823,66 @@ func TestTakeRight(t *testing.T) {
 	}
 }
 
+func TestCycleTake(t *testing.T) {
+	tests := []struct {
+		input    []int
+		n        int
+		expected []int
+	}{
+		{[]int{1, 2, 3}, 5, []int{1, 2, 3, 1, 2}},
+		{[]int{1, 2, 3}, 6, []int{1, 2, 3, 1, 2, 3}},
+		{[]int{1, 2, 3}, 2, []int{1, 2}},
+		{[]int{7}, 3, []int{7, 7, 7}},
+		{[]int{1, 2}, 0, []int{}},
+		{[]int{1, 2}, -1, []int{}},
+		{[]int{}, 3, []int{}},
+	}
+
+	for _, test := range tests {
+		result := CycleTake(test.input, test.n)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("CycleTake(%v, %d) = %v, expected %v", test.input, test.n, result, test.expected)
+		}
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	tests := []struct {
+		input         []int
+		index         int
+		expectedLeft  []int
+		expectedRight []int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []int{1, 2}, []int{3, 4, 5}},
+		{[]int{1, 2, 3}, 0, []int{}, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 3, []int{1, 2, 3}, []int{}},
+		{[]int{1, 2, 3}, 10, []int{1, 2, 3}, []int{}}, // Index past the end is clamped
+		{[]int{1, 2, 3}, -1, []int{}, []int{1, 2, 3}}, // Negative index is clamped to 0
+		{[]int{}, 1, []int{}, []int{}},
+		{nil, 0, []int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		left, right := SplitAt(test.input, test.index)
+		if !reflect.DeepEqual(left, test.expectedLeft) || !reflect.DeepEqual(right, test.expectedRight) {
+			t.Errorf("SplitAt(%v, %d) = (%v, %v), expected (%v, %v)",
+				test.input, test.index, left, right, test.expectedLeft, test.expectedRight)
+		}
+	}
+
+	// The halves must not share memory with the input
+	input := []int{1, 2, 3, 4}
+	left, right := SplitAt(input, 2)
+	left[0], right[0] = 10, 30
+	if !reflect.DeepEqual(input, []int{1, 2, 3, 4}) {
+		t.Errorf("SplitAt modified the input: %v", input)
+	}
+	_ = append(left, 99)
+	if input[2] != 3 {
+		t.Errorf("Appending to the left half overwrote the input: %v", input)
+	}
+}
+
 func TestUnion(t *testing.T) {
 	tests := []struct {
 		inputs   [][]int
@@ -481,6 +929,65 @@ func TestUniq(t *testing.T) {
 	}
 }
 
+func TestDedupSorted(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{1, 1, 2, 3, 3, 3}, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{1, 1, 1}, []int{1}},
+		{[]int{1, 2, 1}, []int{1, 2, 1}}, // Only adjacent duplicates are removed
+		{[]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := DedupSorted(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("DedupSorted(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{1, 2, 3, 2, 1, 2}, []int{2, 1}},
+		{[]int{1, 1, 1}, []int{1}},
+		{[]int{1, 2, 3}, []int{}},
+		{[]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := FindDuplicates(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FindDuplicates(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestFindDuplicatesBy(t *testing.T) {
+	type user struct {
+		ID    int
+		Email string
+	}
+
+	users := []user{{1, "a@x.io"}, {2, "b@x.io"}, {3, "a@x.io"}, {4, "c@x.io"}, {5, "b@x.io"}, {6, "a@x.io"}}
+	expected := []user{{1, "a@x.io"}, {2, "b@x.io"}}
+
+	result := FindDuplicatesBy(users, func(u user) string { return u.Email })
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("FindDuplicatesBy(%v, email) = %v, expected %v", users, result, expected)
+	}
+
+	unique := FindDuplicatesBy([]user{{1, "a@x.io"}}, func(u user) string { return u.Email })
+	if len(unique) != 0 {
+		t.Errorf("FindDuplicatesBy on unique emails = %v, expected []", unique)
+	}
+}
+
 func TestWithout(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -518,6 +1025,38 @@ func TestReverse(t *testing.T) {
 	}
 }
 
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		input    []int
+		n        int
+		expected []int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []int{3, 4, 5, 1, 2}},
+		{[]int{1, 2, 3, 4, 5}, -1, []int{5, 1, 2, 3, 4}},
+		{[]int{1, 2, 3, 4, 5}, 7, []int{3, 4, 5, 1, 2}}, // n is taken modulo the length
+		{[]int{1, 2, 3}, 3, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{[]int{1}, 4, []int{1}},
+		{[]int{}, 2, []int{}},
+	}
+
+	for _, test := range tests {
+		original := append([]int{}, test.input...)
+		result := Rotate(test.input, test.n)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Rotate(%v, %d) = %v, expected %v", test.input, test.n, result, test.expected)
+		}
+		if !reflect.DeepEqual(test.input, original) {
+			t.Errorf("Rotate(%v, %d) modified its input", original, test.n)
+		}
+
+		RotateInPlace(test.input, test.n)
+		if !reflect.DeepEqual(test.input, test.expected) {
+			t.Errorf("RotateInPlace(%v, %d) = %v, expected %v", original, test.n, test.input, test.expected)
+		}
+	}
+}
+
 func TestShuffle(t *testing.T) {
 	// Shuffle is non-deterministic, so we just check that the length is the same
 	// and that all elements from the original array are present in the shuffled array
@@ -586,6 +1125,97 @@ func TestSortedIndex(t *testing.T) {
 	}
 }
 
+func TestSortedInsert(t *testing.T) {
+	tests := []struct {
+		input    []int
+		value    int
+		expected []int
+	}{
+		{[]int{1, 3, 5, 7}, 4, []int{1, 3, 4, 5, 7}},
+		{[]int{1, 3, 5, 7}, 0, []int{0, 1, 3, 5, 7}},
+		{[]int{1, 3, 5, 7}, 8, []int{1, 3, 5, 7, 8}},
+		{[]int{1, 3, 3, 5}, 3, []int{1, 3, 3, 3, 5}},
+		{[]int{}, 1, []int{1}},
+	}
+
+	for _, test := range tests {
+		original := append([]int{}, test.input...)
+		result := SortedInsert(test.input, test.value)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("SortedInsert(%v, %d) = %v, expected %v", test.input, test.value, result, test.expected)
+		}
+		if !reflect.DeepEqual(test.input, original) {
+			t.Errorf("SortedInsert modified its input: %v, expected %v", test.input, original)
+		}
+	}
+}
+
+func TestSortedInsertBy(t *testing.T) {
+	desc := func(i, j string) bool { return i > j }
+
+	tests := []struct {
+		input    []string
+		value    string
+		expected []string
+	}{
+		{[]string{"c", "a"}, "b", []string{"c", "b", "a"}},
+		{[]string{"c", "b"}, "d", []string{"d", "c", "b"}},
+		{[]string{"c", "b"}, "a", []string{"c", "b", "a"}},
+		{[]string{}, "a", []string{"a"}},
+	}
+
+	for _, test := range tests {
+		result := SortedInsertBy(test.input, test.value, desc)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("SortedInsertBy(%v, %q, desc) = %v, expected %v", test.input, test.value, result, test.expected)
+		}
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		a        []int
+		b        []int
+		expected []int
+	}{
+		{[]int{1, 3, 5}, []int{2, 4, 6}, []int{1, 2, 3, 4, 5, 6}},
+		{[]int{1, 2, 2}, []int{2, 3}, []int{1, 2, 2, 2, 3}},
+		{[]int{4, 5}, []int{1, 2}, []int{1, 2, 4, 5}},
+		{[]int{}, []int{1}, []int{1}},
+		{[]int{1}, []int{}, []int{1}},
+		{[]int{}, []int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := MergeSorted(test.a, test.b)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("MergeSorted(%v, %v) = %v, expected %v", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestMergeSortedBy(t *testing.T) {
+	type item struct {
+		key    int
+		source string
+	}
+	byKey := func(i, j item) bool { return i.key < j.key }
+
+	a := []item{{1, "a"}, {2, "a"}}
+	b := []item{{2, "b"}, {3, "b"}}
+	expected := []item{{1, "a"}, {2, "a"}, {2, "b"}, {3, "b"}}
+
+	result := MergeSortedBy(a, b, byKey)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("MergeSortedBy(%v, %v, byKey) = %v, expected %v", a, b, result, expected)
+	}
+
+	desc := MergeSortedBy([]int{5, 3, 1}, []int{4, 2}, func(i, j int) bool { return i > j })
+	if !reflect.DeepEqual(desc, []int{5, 4, 3, 2, 1}) {
+		t.Errorf("MergeSortedBy descending = %v, expected %v", desc, []int{5, 4, 3, 2, 1})
+	}
+}
+
 func TestZip(t *testing.T) {
 	tests := []struct {
 		inputs   [][]int
@@ -604,6 +1234,81 @@ func TestZip(t *testing.T) {
 	}
 }
 
+func TestZipPair(t *testing.T) {
+	type pair = struct {
+		First  string
+		Second int
+	}
+
+	tests := []struct {
+		a        []string
+		b        []int
+		expected []pair
+	}{
+		{[]string{"a", "b"}, []int{1, 2}, []pair{{"a", 1}, {"b", 2}}},
+		{[]string{"a", "b", "c"}, []int{1, 2}, []pair{{"a", 1}, {"b", 2}}}, // Only zips up to the shorter length
+		{[]string{"a"}, []int{1, 2, 3}, []pair{{"a", 1}}},
+		{[]string{}, []int{1}, []pair{}},
+		{nil, nil, []pair{}},
+	}
+
+	for _, test := range tests {
+		result := ZipPair(test.a, test.b)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ZipPair(%v, %v) = %v, expected %v", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestUnzip2(t *testing.T) {
+	type pair = struct {
+		First  string
+		Second int
+	}
+
+	tests := []struct {
+		input          []pair
+		expectedFirst  []string
+		expectedSecond []int
+	}{
+		{[]pair{{"a", 1}, {"b", 2}}, []string{"a", "b"}, []int{1, 2}},
+		{[]pair{}, []string{}, []int{}},
+	}
+
+	for _, test := range tests {
+		first, second := Unzip2(test.input)
+		if !reflect.DeepEqual(first, test.expectedFirst) || !reflect.DeepEqual(second, test.expectedSecond) {
+			t.Errorf("Unzip2(%v) = (%v, %v), expected (%v, %v)", test.input, first, second, test.expectedFirst, test.expectedSecond)
+		}
+	}
+
+	// Round trip
+	keys, values := Unzip2(ZipPair([]string{"x", "y"}, []int{7, 8}))
+	if !reflect.DeepEqual(keys, []string{"x", "y"}) || !reflect.DeepEqual(values, []int{7, 8}) {
+		t.Errorf("Unzip2(ZipPair(...)) = (%v, %v), expected ([x y], [7 8])", keys, values)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		input    [][]int
+		expected [][]int
+	}{
+		{[][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		{[][]int{{1, 2}, {3}}, [][]int{{1, 3}, {2, 0}}},           // Ragged rows are zero-padded
+		{[][]int{{1}, {}, {2, 3}}, [][]int{{1, 0, 2}, {0, 0, 3}}}, // Empty rows become zero columns
+		{[][]int{{}, {}}, [][]int{}},
+		{[][]int{}, [][]int{}},
+	}
+
+	for _, test := range tests {
+		result := Transpose(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Transpose(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestSortBy(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -642,6 +1347,48 @@ func TestPull(t *testing.T) {
 	}
 }
 
+func TestReplaceValue(t *testing.T) {
+	tests := []struct {
+		input    []int
+		oldValue int
+		newValue int
+		expected []int
+	}{
+		{[]int{1, 2, 1, 3}, 1, 9, []int{9, 2, 9, 3}},
+		{[]int{1, 2}, 3, 9, []int{1, 2}},
+		{[]int{}, 1, 9, []int{}},
+	}
+
+	for _, test := range tests {
+		original := append([]int{}, test.input...)
+		result := ReplaceValue(test.input, test.oldValue, test.newValue)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ReplaceValue(%v, %d, %d) = %v, expected %v", test.input, test.oldValue, test.newValue, result, test.expected)
+		}
+		if !reflect.DeepEqual(test.input, original) {
+			t.Errorf("ReplaceValue modified its input: %v, expected %v", test.input, original)
+		}
+	}
+}
+
+func TestReplaceWhere(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{-1, 2, -3}, []int{0, 2, 0}},
+		{[]int{1, 2}, []int{1, 2}},
+		{[]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := ReplaceWhere(test.input, func(n int) bool { return n < 0 }, 0)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ReplaceWhere(%v, isNegative, 0) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestRandom(t *testing.T) {
 	// Test empty slice
 	result := Random([]int{}, 3)
@@ -682,6 +1429,51 @@ func TestRandom(t *testing.T) {
 	}
 }
 
+func TestRandomSubset(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	// Probability bounds
+	if result := RandomSubset(input, 0); len(result) != 0 {
+		t.Errorf("RandomSubset(%v, 0) = %v, expected []", input, result)
+	}
+	if result := RandomSubset(input, -1); len(result) != 0 {
+		t.Errorf("RandomSubset(%v, -1) = %v, expected []", input, result)
+	}
+	if result := RandomSubset(input, 1); !reflect.DeepEqual(result, input) {
+		t.Errorf("RandomSubset(%v, 1) = %v, expected %v", input, result, input)
+	}
+
+	// Sampled elements keep their original order
+	result := RandomSubset(input, 0.5)
+	if !sort.IntsAreSorted(result) {
+		t.Errorf("RandomSubset(%v, 0.5) = %v, expected elements in original order", input, result)
+	}
+	if !IsSubset(result, input) {
+		t.Errorf("RandomSubset(%v, 0.5) = %v, contains elements not in input", input, result)
+	}
+}
+
+func TestRandomSubsetWithSeed(t *testing.T) {
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+
+	first := RandomSubsetWithSeed(input, 0.3, 42)
+	second := RandomSubsetWithSeed(input, 0.3, 42)
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("RandomSubsetWithSeed with the same seed returned different subsets: %v and %v", first, second)
+	}
+
+	// With 1000 elements the sample size should be close to the expected 300
+	if len(first) < 200 || len(first) > 400 {
+		t.Errorf("RandomSubsetWithSeed(input, 0.3, 42) returned %d elements, expected about 300", len(first))
+	}
+	if !sort.IntsAreSorted(first) {
+		t.Errorf("RandomSubsetWithSeed(input, 0.3, 42) did not preserve the original order")
+	}
+}
+
 func TestRandomChoice(t *testing.T) {
 	// Test empty slice
 	_, ok := RandomChoice([]int{})
@@ -722,17 +1514,178 @@ func TestContains(t *testing.T) {
 		element  int
 		expected bool
 	}{
-		{[]int{1, 2, 3}, 2, true},
-		{[]int{1, 2, 3}, 4, false},
-		{[]int{}, 1, false},
+		{[]int{1, 2, 3}, 2, true},
+		{[]int{1, 2, 3}, 4, false},
+		{[]int{}, 1, false},
+	}
+
+	for _, test := range tests {
+		result := Contains(test.input, test.element)
+		if result != test.expected {
+			t.Errorf("Contains(%v, %d) = %v, expected %v", test.input, test.element, result, test.expected)
+		}
+	}
+}
+
+func TestContainsBy(t *testing.T) {
+	type user struct {
+		ID    int
+		Roles []string
+	}
+	hasRoles := func(u user) bool { return len(u.Roles) > 0 }
+
+	tests := []struct {
+		input    []user
+		expected bool
+	}{
+		{[]user{{ID: 1}, {ID: 2, Roles: []string{"admin"}}}, true},
+		{[]user{{ID: 1}, {ID: 2}}, false},
+		{[]user{}, false},
+	}
+
+	for _, test := range tests {
+		result := ContainsBy(test.input, hasRoles)
+		if result != test.expected {
+			t.Errorf("ContainsBy(%v, hasRoles) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	tests := []struct {
+		input    []int
+		values   []int
+		expected bool
+	}{
+		{[]int{1, 2, 3}, []int{1, 3}, true},
+		{[]int{1, 2, 3}, []int{1, 4}, false},
+		{[]int{1, 2, 3}, []int{2, 2}, true},
+		{[]int{1, 2, 3}, []int{}, true}, // No values to check
+		{[]int{}, []int{1}, false},
+		{[]int{}, []int{}, true},
+	}
+
+	for _, test := range tests {
+		result := ContainsAll(test.input, test.values...)
+		if result != test.expected {
+			t.Errorf("ContainsAll(%v, %v) = %v, expected %v", test.input, test.values, result, test.expected)
+		}
+	}
+}
+
+func TestContainsAny(t *testing.T) {
+	tests := []struct {
+		input    []int
+		values   []int
+		expected bool
+	}{
+		{[]int{1, 2, 3}, []int{5, 3}, true},
+		{[]int{1, 2, 3}, []int{4, 5}, false},
+		{[]int{1, 2, 3}, []int{}, false}, // No values to check
+		{[]int{}, []int{1}, false},
+		{[]int{}, []int{}, false},
+	}
+
+	for _, test := range tests {
+		result := ContainsAny(test.input, test.values...)
+		if result != test.expected {
+			t.Errorf("ContainsAny(%v, %v) = %v, expected %v", test.input, test.values, result, test.expected)
+		}
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	tests := []struct {
+		sub      []string
+		super    []string
+		expected bool
+	}{
+		{[]string{"editor"}, []string{"viewer", "editor"}, true},
+		{[]string{"admin", "editor"}, []string{"editor"}, false},
+		{[]string{"a", "a"}, []string{"a"}, true}, // Duplicates are ignored
+		{[]string{"b", "a"}, []string{"a", "b"}, true},
+		{[]string{}, []string{"a"}, true},
+		{[]string{}, []string{}, true},
+		{[]string{"a"}, []string{}, false},
+	}
+
+	for _, test := range tests {
+		result := IsSubset(test.sub, test.super)
+		if result != test.expected {
+			t.Errorf("IsSubset(%v, %v) = %v, expected %v", test.sub, test.super, result, test.expected)
+		}
+	}
+}
+
+func TestIsSuperset(t *testing.T) {
+	tests := []struct {
+		super    []int
+		sub      []int
+		expected bool
+	}{
+		{[]int{1, 2, 3}, []int{2, 3}, true},
+		{[]int{1, 2}, []int{2, 3}, false},
+		{[]int{1}, []int{}, true},
+		{[]int{}, []int{1}, false},
+	}
+
+	for _, test := range tests {
+		result := IsSuperset(test.super, test.sub)
+		if result != test.expected {
+			t.Errorf("IsSuperset(%v, %v) = %v, expected %v", test.super, test.sub, result, test.expected)
+		}
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	tests := []struct {
+		a        []int
+		b        []int
+		expected bool
+	}{
+		{[]int{1, 2, 3}, []int{3, 1, 2}, true},
+		{[]int{1, 1, 2}, []int{1, 2, 1}, true},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, false}, // Same elements, different counts
+		{[]int{1, 2}, []int{1, 2, 2}, false},
+		{[]int{1, 2}, []int{1, 3}, false},
+		{[]int{}, []int{}, true},
+		{nil, []int{}, true},
+	}
+
+	for _, test := range tests {
+		result := EqualUnordered(test.a, test.b)
+		if result != test.expected {
+			t.Errorf("EqualUnordered(%v, %v) = %v, expected %v", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func TestEqualBy(t *testing.T) {
+	tests := []struct {
+		a        []string
+		b        []string
+		expected bool
+	}{
+		{[]string{"Go", "WEB"}, []string{"go", "web"}, true},
+		{[]string{"go", "web"}, []string{"web", "go"}, false}, // Order matters
+		{[]string{"go"}, []string{"go", "web"}, false},
+		{[]string{}, []string{}, true},
+		{nil, []string{}, true},
 	}
 
 	for _, test := range tests {
-		result := Contains(test.input, test.element)
+		result := EqualBy(test.a, test.b, strings.EqualFold)
 		if result != test.expected {
-			t.Errorf("Contains(%v, %d) = %v, expected %v", test.input, test.element, result, test.expected)
+			t.Errorf("EqualBy(%v, %v, EqualFold) = %v, expected %v", test.a, test.b, result, test.expected)
 		}
 	}
+
+	// Non-comparable element types
+	a := [][]int{{1, 2}, {3}}
+	b := [][]int{{1, 2}, {3}}
+	if !EqualBy(a, b, func(x, y []int) bool { return reflect.DeepEqual(x, y) }) {
+		t.Errorf("EqualBy(%v, %v, DeepEqual) = false, expected true", a, b)
+	}
 }
 
 func TestFilter(t *testing.T) {
@@ -753,6 +1706,39 @@ func TestFilter(t *testing.T) {
 	}
 }
 
+func TestPartition(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	tests := []struct {
+		input           []int
+		expectedMatched []int
+		expectedRest    []int
+	}{
+		{[]int{1, 2, 3, 4, 5}, []int{2, 4}, []int{1, 3, 5}},
+		{[]int{2, 4}, []int{2, 4}, []int{}},
+		{[]int{1, 3}, []int{}, []int{1, 3}},
+		{[]int{}, []int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		matched, rest := Partition(test.input, isEven)
+		if !reflect.DeepEqual(matched, test.expectedMatched) || !reflect.DeepEqual(rest, test.expectedRest) {
+			t.Errorf("Partition(%v, isEven) = (%v, %v), expected (%v, %v)",
+				test.input, matched, rest, test.expectedMatched, test.expectedRest)
+		}
+		if matched == nil || rest == nil {
+			t.Errorf("Partition(%v, isEven) returned a nil slice", test.input)
+		}
+	}
+
+	// The input is not modified
+	input := []int{1, 2, 3}
+	Partition(input, isEven)
+	if !reflect.DeepEqual(input, []int{1, 2, 3}) {
+		t.Errorf("Partition modified its input: %v", input)
+	}
+}
+
 func TestMap(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -770,6 +1756,32 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestWalkSlice(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{1, 2, -1, 3}, []int{0, 1}},
+		{[]int{1, 2, 3}, []int{0, 1, 2}},
+		{[]int{-1, 2}, []int{}},
+		{[]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		visited := []int{}
+		WalkSlice(test.input, func(i int, v int) bool {
+			if v < 0 {
+				return false
+			}
+			visited = append(visited, i)
+			return true
+		})
+		if !reflect.DeepEqual(visited, test.expected) {
+			t.Errorf("WalkSlice(%v) visited %v, expected %v", test.input, visited, test.expected)
+		}
+	}
+}
+
 func TestFind(t *testing.T) {
 	tests := []struct {
 		input      []int
@@ -1060,6 +2072,40 @@ func TestMapMergeMaps(t *testing.T) {
 	}
 }
 
+func TestMapMergeFunc(t *testing.T) {
+	sum := func(key string, a, b int) int { return a + b }
+	keepMax := func(key string, a, b int) int { return max(a, b) }
+
+	tests := []struct {
+		name     string
+		resolve  func(string, int, int) int
+		maps     []map[string]int
+		expected map[string]int
+	}{
+		{"sum", sum, []map[string]int{{"a": 1, "b": 2}, {"b": 3, "c": 4}, {"b": 10}}, map[string]int{"a": 1, "b": 15, "c": 4}},
+		{"max", keepMax, []map[string]int{{"a": 5, "b": 2}, {"a": 1, "b": 3}}, map[string]int{"a": 5, "b": 3}},
+		{"nil resolve keeps last", nil, []map[string]int{{"a": 1}, {"a": 2}}, map[string]int{"a": 2}},
+		{"no maps", sum, []map[string]int{}, map[string]int{}},
+	}
+
+	for _, test := range tests {
+		result := MapMergeFunc(test.resolve, test.maps...)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("MapMergeFunc(%s, %v) = %v, expected %v", test.name, test.maps, result, test.expected)
+		}
+	}
+
+	// resolve is only called on collisions, with the merged value first
+	var calls []string
+	MapMergeFunc(func(key string, a, b string) string {
+		calls = append(calls, key+":"+a+"+"+b)
+		return a + b
+	}, map[string]string{"x": "1", "y": "2"}, map[string]string{"x": "3"})
+	if !reflect.DeepEqual(calls, []string{"x:1+3"}) {
+		t.Errorf("MapMergeFunc resolve calls = %v, expected %v", calls, []string{"x:1+3"})
+	}
+}
+
 func TestMapKeys(t *testing.T) {
 	tests := []struct {
 		m        map[string]int
@@ -1306,6 +2352,83 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestGroupBy2(t *testing.T) {
+	type sale struct {
+		Region  string
+		Product string
+		Amount  int
+	}
+	region := func(s sale) string { return s.Region }
+	product := func(s sale) string { return s.Product }
+
+	tests := []struct {
+		input    []sale
+		expected map[string]map[string][]sale
+	}{
+		{
+			[]sale{{"eu", "book", 10}, {"us", "pen", 2}, {"eu", "book", 5}, {"eu", "pen", 1}},
+			map[string]map[string][]sale{
+				"eu": {"book": {{"eu", "book", 10}, {"eu", "book", 5}}, "pen": {{"eu", "pen", 1}}},
+				"us": {"pen": {{"us", "pen", 2}}},
+			},
+		},
+		{
+			[]sale{},
+			map[string]map[string][]sale{},
+		},
+	}
+
+	for _, test := range tests {
+		result := GroupBy2(test.input, region, product)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("GroupBy2(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	// Keys may have different types
+	byParity := GroupBy2([]int{1, 2, 3, 4, 5}, func(n int) bool { return n%2 == 0 }, func(n int) int { return n / 3 })
+	expected := map[bool]map[int][]int{false: {0: {1}, 1: {3, 5}}, true: {0: {2}, 1: {4}}}
+	if !reflect.DeepEqual(byParity, expected) {
+		t.Errorf("GroupBy2(ints) = %v, expected %v", byParity, expected)
+	}
+}
+
+func TestGroupConsecutiveBy(t *testing.T) {
+	identity := func(s string) string { return s }
+	summarize := func(key string, items []string) string {
+		return key + " (x" + strconv.Itoa(len(items)) + ")"
+	}
+
+	tests := []struct {
+		input    []string
+		expected []string
+	}{
+		{[]string{"ok", "ok", "ok", "fail", "ok"}, []string{"ok (x3)", "fail (x1)", "ok (x1)"}},
+		{[]string{"a", "b", "c"}, []string{"a (x1)", "b (x1)", "c (x1)"}},
+		{[]string{"a", "a"}, []string{"a (x2)"}},
+		{[]string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		result := GroupConsecutiveBy(test.input, identity, summarize)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("GroupConsecutiveBy(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	// Aggregating by a derived key
+	sums := GroupConsecutiveBy([]int{1, 3, 2, 4, 5}, func(n int) bool { return n%2 == 0 }, func(_ bool, items []int) int {
+		total := 0
+		for _, n := range items {
+			total += n
+		}
+		return total
+	})
+	if !reflect.DeepEqual(sums, []int{4, 6, 5}) {
+		t.Errorf("GroupConsecutiveBy by parity = %v, expected %v", sums, []int{4, 6, 5})
+	}
+}
+
 func TestMapDiffMaps(t *testing.T) {
 	tests := []struct {
 		m1              map[string]int
@@ -1364,6 +2487,98 @@ func TestMapDiffMaps(t *testing.T) {
 	}
 }
 
+func TestMapBatch(t *testing.T) {
+	m := map[int]string{1: "a", 2: "b", 3: "c", 4: "d", 5: "e"}
+
+	tests := []struct {
+		size          int
+		expectedSizes []int
+	}{
+		{2, []int{2, 2, 1}},
+		{5, []int{5}},
+		{10, []int{5}},
+		{0, []int{5}}, // Non-positive size means one batch
+	}
+
+	for _, test := range tests {
+		var sizes []int
+		merged := make(map[int]string)
+		err := MapBatch(m, test.size, func(batch map[int]string) error {
+			sizes = append(sizes, len(batch))
+			for k, v := range batch {
+				merged[k] = v
+			}
+			return nil
+		})
+		if err != nil {
+			t.Errorf("MapBatch(m, %d) returned error %v", test.size, err)
+		}
+		if !reflect.DeepEqual(sizes, test.expectedSizes) {
+			t.Errorf("MapBatch(m, %d) batch sizes = %v, expected %v", test.size, sizes, test.expectedSizes)
+		}
+		if !reflect.DeepEqual(merged, m) {
+			t.Errorf("MapBatch(m, %d) visited %v, expected %v", test.size, merged, m)
+		}
+	}
+
+	// Stops on the first error
+	calls := 0
+	errStop := errors.New("stop")
+	err := MapBatch(m, 2, func(batch map[int]string) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(err, errStop) || calls != 1 {
+		t.Errorf("MapBatch with failing fn = %v after %d calls, expected %v after 1 call", err, calls, errStop)
+	}
+
+	// Empty maps never call fn
+	err = MapBatch(map[int]string{}, 2, func(batch map[int]string) error {
+		t.Errorf("MapBatch called fn for an empty map")
+		return nil
+	})
+	if err != nil {
+		t.Errorf("MapBatch on an empty map returned error %v", err)
+	}
+}
+
+func TestSumByKey(t *testing.T) {
+	tests := []struct {
+		input    map[string][]float64
+		expected map[string]float64
+	}{
+		{
+			map[string][]float64{"alice": {10, 5.5}, "bob": {3}},
+			map[string]float64{"alice": 15.5, "bob": 3},
+		},
+		{
+			map[string][]float64{"carol": {}, "dave": nil},
+			map[string]float64{"carol": 0, "dave": 0},
+		},
+		{
+			map[string][]float64{},
+			map[string]float64{},
+		},
+	}
+
+	for _, test := range tests {
+		result := SumByKey(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("SumByKey(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestSumByKeyOf(t *testing.T) {
+	input := map[string][]int{"errors": {2, 3}, "warnings": {1}, "notices": {}}
+	expected := map[string]int{"errors": 5, "warnings": 1, "notices": 0}
+
+	result := SumByKeyOf(input)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("SumByKeyOf(%v) = %v, expected %v", input, result, expected)
+	}
+}
+
 func TestMapGetOrInsert(t *testing.T) {
 	tests := []struct {
 		m            map[string]int
@@ -1467,6 +2682,36 @@ func TestMapInvertMap(t *testing.T) {
 	}
 }
 
+func TestReverseGroups(t *testing.T) {
+	tests := []struct {
+		input    map[string][]string
+		expected map[string][]string
+	}{
+		{
+			map[string][]string{"go": {"ann", "bob"}, "rust": {"bob"}},
+			map[string][]string{"ann": {"go"}, "bob": {"go", "rust"}},
+		},
+		{
+			map[string][]string{"go": {"ann", "ann"}, "zig": {}},
+			map[string][]string{"ann": {"go"}}, // Repeated values count once, empty groups vanish
+		},
+		{
+			map[string][]string{},
+			map[string][]string{},
+		},
+	}
+
+	for _, test := range tests {
+		result := ReverseGroups(test.input)
+		for _, keys := range result {
+			sort.Strings(keys)
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ReverseGroups(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 // Helper function to check if a map has duplicate values
 func hasDuplicateValues[K comparable, V comparable](m map[K]V) bool {
 	seen := make(map[V]bool)
@@ -1885,6 +3130,56 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestNormalizeJSONNumbers(t *testing.T) {
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(`{"age": 30, "score": 9.5, "tags": [1, 2.5, "x"], "user": {"id": 7, "active": true}}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+
+	expected := map[string]any{
+		"age":   30,
+		"score": 9.5,
+		"tags":  []any{1, 2.5, "x"},
+		"user":  map[string]any{"id": 7, "active": true},
+	}
+
+	result := NormalizeJSONNumbers(decoded)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("NormalizeJSONNumbers(%v) = %v, expected %v", decoded, result, expected)
+	}
+
+	// The input is not modified
+	if _, ok := decoded["age"].(float64); !ok {
+		t.Errorf("NormalizeJSONNumbers modified its input: %v", decoded)
+	}
+
+	// Normalized values work with Get
+	if age := Get(result.(map[string]any), "user.id", nil); age != 7 {
+		t.Errorf("Get(normalized, \"user.id\", nil) = %v (%T), expected 7", age, age)
+	}
+
+	tests := []struct {
+		input    any
+		expected any
+	}{
+		{float64(3), 3},
+		{3.25, 3.25},
+		{json.Number("42"), 42},
+		{json.Number("4.5"), 4.5},
+		{json.Number("4.0"), 4},
+		{math.Inf(1), math.Inf(1)},
+		{"text", "text"},
+		{nil, nil},
+	}
+
+	for _, test := range tests {
+		result := NormalizeJSONNumbers(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("NormalizeJSONNumbers(%v) = %v (%T), expected %v (%T)", test.input, result, result, test.expected, test.expected)
+		}
+	}
+}
+
 func TestHas(t *testing.T) {
 	tests := []struct {
 		array    map[string]any
@@ -2088,6 +3383,31 @@ func TestKeyBy(t *testing.T) {
 	}
 }
 
+func TestAssociateWith(t *testing.T) {
+	tests := []struct {
+		input    []string
+		expected map[string]int
+	}{
+		{[]string{"apple", "kiwi"}, map[string]int{"apple": 5, "kiwi": 4}},
+		{[]string{"a", "bb", "a"}, map[string]int{"a": 1, "bb": 2}},
+		{[]string{}, map[string]int{}},
+	}
+
+	for _, test := range tests {
+		calls := 0
+		result := AssociateWith(test.input, func(s string) int {
+			calls++
+			return len(s)
+		})
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("AssociateWith(%v, len) = %v, expected %v", test.input, result, test.expected)
+		}
+		if calls != len(test.expected) {
+			t.Errorf("AssociateWith(%v, len) called valueFn %d times, expected %d", test.input, calls, len(test.expected))
+		}
+	}
+}
+
 func TestOnly(t *testing.T) {
 	tests := []struct {
 		array    map[string]any
@@ -2437,6 +3757,61 @@ func TestWhereNotNull(t *testing.T) {
 	}
 }
 
+func TestToPointers(t *testing.T) {
+	tests := []struct {
+		input []int
+	}{
+		{[]int{1, 2, 3}},
+		{[]int{}},
+	}
+
+	for _, test := range tests {
+		result := ToPointers(test.input)
+		if len(result) != len(test.input) {
+			t.Errorf("ToPointers(%v) returned %d pointers, expected %d", test.input, len(result), len(test.input))
+			continue
+		}
+		for i, ptr := range result {
+			if ptr == nil || *ptr != test.input[i] {
+				t.Errorf("ToPointers(%v)[%d] = %v, expected pointer to %d", test.input, i, ptr, test.input[i])
+			}
+		}
+	}
+
+	// Writing through the pointers does not modify the input
+	input := []int{1, 2}
+	*ToPointers(input)[0] = 9
+	if !reflect.DeepEqual(input, []int{1, 2}) {
+		t.Errorf("ToPointers aliases its input: %v", input)
+	}
+}
+
+func TestDeref(t *testing.T) {
+	one, two := 1, 2
+
+	tests := []struct {
+		input    []*int
+		def      int
+		expected []int
+	}{
+		{[]*int{&one, nil, &two}, -1, []int{1, -1, 2}},
+		{[]*int{nil, nil}, 0, []int{0, 0}},
+		{[]*int{}, 0, []int{}},
+	}
+
+	for _, test := range tests {
+		result := Deref(test.input, test.def)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Deref(%v, %d) = %v, expected %v", test.input, test.def, result, test.expected)
+		}
+	}
+
+	// Round trip
+	if result := Deref(ToPointers([]string{"a", "b"}), ""); !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("Deref(ToPointers) = %v, expected %v", result, []string{"a", "b"})
+	}
+}
+
 func TestWrap(t *testing.T) {
 	tests := []struct {
 		input    any
@@ -2468,6 +3843,44 @@ func TestWrap(t *testing.T) {
 	}
 }
 
+func TestToAnySlice(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []any
+	}{
+		{[]int{1, 2, 3}, []any{1, 2, 3}},
+		{[]int{}, []any{}},
+	}
+
+	for _, test := range tests {
+		result := ToAnySlice(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ToAnySlice(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestFromAnySlice(t *testing.T) {
+	tests := []struct {
+		input    []any
+		expected []int
+		ok       bool
+	}{
+		{[]any{1, 2, 3}, []int{1, 2, 3}, true},
+		{[]any{}, []int{}, true},
+		{[]any{1, "2", 3}, nil, false},
+		{[]any{1, int64(2)}, nil, false},
+		{[]any{nil}, nil, false},
+	}
+
+	for _, test := range tests {
+		result, ok := FromAnySlice[int](test.input)
+		if !reflect.DeepEqual(result, test.expected) || ok != test.ok {
+			t.Errorf("FromAnySlice(%v) = %v, %v, expected %v, %v", test.input, result, ok, test.expected, test.ok)
+		}
+	}
+}
+
 func TestMapFindKey(t *testing.T) {
 	tests := []struct {
 		m           map[string]int
@@ -2610,3 +4023,74 @@ func TestMapSliceToMap(t *testing.T) {
 		}
 	}
 }
+
+func benchmarkInts(n int) []int {
+	array := make([]int, n)
+	for i := range array {
+		array[i] = i
+	}
+	return array
+}
+
+func BenchmarkIncludesRepeated(b *testing.B) {
+	array := benchmarkInts(10000)
+	for i := 0; i < b.N; i++ {
+		for v := 0; v < 1000; v++ {
+			_ = Includes(array, v*10)
+		}
+	}
+}
+
+func BenchmarkNewMembershipRepeated(b *testing.B) {
+	array := benchmarkInts(10000)
+	for i := 0; i < b.N; i++ {
+		contains := NewMembership(array)
+		for v := 0; v < 1000; v++ {
+			_ = contains(v * 10)
+		}
+	}
+}
+
+func BenchmarkIntersectionSkewed(b *testing.B) {
+	large := benchmarkInts(100000)
+	small := []int{5, 500, 50000, 200000}
+	for i := 0; i < b.N; i++ {
+		_ = Intersection(large, small, large)
+	}
+}
+
+func benchmarkSortedWithDuplicates(n int) []int {
+	array := make([]int, n)
+	for i := range array {
+		array[i] = i / 4
+	}
+	return array
+}
+
+func BenchmarkUniqSorted(b *testing.B) {
+	array := benchmarkSortedWithDuplicates(100000)
+	for i := 0; i < b.N; i++ {
+		_ = Uniq(array)
+	}
+}
+
+func BenchmarkDedupSorted(b *testing.B) {
+	array := benchmarkSortedWithDuplicates(100000)
+	for i := 0; i < b.N; i++ {
+		_ = DedupSorted(array)
+	}
+}
+
+func BenchmarkRotate(b *testing.B) {
+	array := benchmarkInts(1000000)
+	for i := 0; i < b.N; i++ {
+		array = Rotate(array, 12345)
+	}
+}
+
+func BenchmarkRotateInPlace(b *testing.B) {
+	array := benchmarkInts(1000000)
+	for i := 0; i < b.N; i++ {
+		RotateInPlace(array, 12345)
+	}
+}
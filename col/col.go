@@ -3,10 +3,13 @@
 package col
 
 import (
+	"fmt"
 	"github.com/gflydev/utils/arr"
 	"github.com/gflydev/utils/num"
+	"iter"
 	"math/rand/v2"
 	"sort"
+	"strings"
 )
 
 // CountBy counts elements in a collection based on a key generated by an iteratee function.
@@ -36,6 +39,86 @@ func CountBy[T any, K comparable](collection []T, iteratee func(T) K) map[K]int
 	return result
 }
 
+// CountByMulti counts elements in a collection across several dimensions at once.
+// Each element's composite key is built by formatting the result of every key function
+// with fmt.Sprint and joining them with "|", in the order the functions are given
+// (e.g. "active|eu"). Keys that themselves contain "|" make composite keys ambiguous.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - keyFuncs: The functions that return each dimension's key for an element
+//
+// Returns:
+//   - map[string]int: A map from composite key to the number of elements, or an empty map
+//     if no key functions are given
+//
+// Example:
+//
+//	type order struct{ Status, Region string }
+//	orders := []order{{"paid", "eu"}, {"paid", "us"}, {"paid", "eu"}}
+//	CountByMulti(orders,
+//	    func(o order) string { return o.Status },
+//	    func(o order) string { return o.Region },
+//	)
+//	// Returns: map[string]int{"paid|eu": 2, "paid|us": 1}
+func CountByMulti[T any, K comparable](collection []T, keyFuncs ...func(T) K) map[string]int {
+	result := make(map[string]int)
+	if len(keyFuncs) == 0 {
+		return result
+	}
+
+	parts := make([]string, len(keyFuncs))
+	for _, item := range collection {
+		for i, keyFunc := range keyFuncs {
+			parts[i] = fmt.Sprint(keyFunc(item))
+		}
+		result[strings.Join(parts, "|")]++
+	}
+	return result
+}
+
+// Tally counts the occurrences of each distinct value in a collection and returns them
+// ordered from most to least frequent. Values with the same count keep the order in which
+// they first appear in the collection.
+//
+// Parameters:
+//   - collection: The slice to process
+//
+// Returns:
+//   - A new slice of structs, each containing a Value and its Count
+//
+// Example:
+//
+//	Tally([]string{"b", "a", "c", "a", "b", "a"})
+//	// Returns: [{Value: "a", Count: 3}, {Value: "b", Count: 2}, {Value: "c", Count: 1}]
+func Tally[T comparable](collection []T) []struct {
+	Value T
+	Count int
+} {
+	result := make([]struct {
+		Value T
+		Count int
+	}, 0)
+	index := make(map[T]int)
+
+	for _, item := range collection {
+		if i, ok := index[item]; ok {
+			result[i].Count++
+			continue
+		}
+		index[item] = len(result)
+		result = append(result, struct {
+			Value T
+			Count int
+		}{item, 1})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Count > result[j].Count
+	})
+	return result
+}
+
 // Every checks if all elements in the collection satisfy the predicate.
 //
 // Parameters:
@@ -58,6 +141,29 @@ func Every[T any](collection []T, predicate func(T) bool) bool {
 	return true
 }
 
+// EveryWithIndex checks if all elements in the collection satisfy the predicate,
+// which also receives the index of each element.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - predicate: The function that receives each element and its index
+//
+// Returns:
+//   - bool: True if all elements satisfy the predicate, false otherwise
+//
+// Example:
+//
+//	EveryWithIndex([]int{0, 1, 0, 1}, func(n int, i int) bool { return n == i%2 })
+//	// Returns: true
+func EveryWithIndex[T any](collection []T, predicate func(T, int) bool) bool {
+	for i, item := range collection {
+		if !predicate(item, i) {
+			return false
+		}
+	}
+	return true
+}
+
 // Filter filters elements of a collection that satisfy the predicate.
 //
 // Parameters:
@@ -105,6 +211,62 @@ func Find[T any](collection []T, predicate func(T) bool) (T, bool) {
 	return zero, false
 }
 
+// FindWithIndex finds the first element in the collection that satisfies the predicate
+// and returns it together with its index.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - predicate: The function that returns true for the element to find
+//
+// Returns:
+//   - T: The first element that satisfies the predicate
+//   - int: The index of that element, or -1 if none was found
+//   - bool: True if an element was found, false otherwise
+//
+// Example:
+//
+//	FindWithIndex([]int{1, 2, 3, 4}, func(n int) bool { return n > 2 })
+//	// Returns: 3, 2, true
+//
+//	FindWithIndex([]int{1, 2}, func(n int) bool { return n > 2 })
+//	// Returns: 0, -1, false
+func FindWithIndex[T any](collection []T, predicate func(T) bool) (T, int, bool) {
+	var zero T
+	for i, item := range collection {
+		if predicate(item) {
+			return item, i, true
+		}
+	}
+	return zero, -1, false
+}
+
+// FindOrAppend returns the first element that satisfies the predicate, or appends the
+// element returned by create and returns it. This is the "get or create" pattern for
+// in-memory slices; create is only called when no element matches.
+//
+// Parameters:
+//   - collection: The slice to search
+//   - predicate: The function that returns true for the element to find
+//   - create: The function that builds a new element when none is found
+//
+// Returns:
+//   - []T: The collection, with the new element appended if one was created
+//   - T: The existing or newly created element
+//
+// Example:
+//
+//	tags := []string{"go", "rust"}
+//	tags, tag := FindOrAppend(tags, func(s string) bool { return s == "zig" }, func() string { return "zig" })
+//	// tags: []string{"go", "rust", "zig"}, tag: "zig"
+func FindOrAppend[T any](collection []T, predicate func(T) bool, create func() T) ([]T, T) {
+	if item, found := Find(collection, predicate); found {
+		return collection, item
+	}
+
+	item := create()
+	return append(collection, item), item
+}
+
 // FindLast returns the last element in a collection that satisfies a predicate.
 //
 // Parameters:
@@ -201,6 +363,96 @@ func GroupBy[T any, K comparable](collection []T, iteratee func(T) K) map[K][]T
 	return result
 }
 
+// GroupBySortedBySize groups elements of a collection by a key and returns the groups
+// ordered by descending member count. Groups of equal size are ordered by ascending key.
+// Elements keep their original order within each group.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - keyFunc: The function that returns the key to group by
+//
+// Returns:
+//   - []struct{Key K; Items []T}: The groups, largest first
+//
+// Example:
+//
+//	result := GroupBySortedBySize([]string{"go", "js", "rust", "c", "zig"}, func(s string) int { return len(s) })
+//	// Returns: []struct{Key int; Items []string}{
+//	//     {Key: 2, Items: []string{"go", "js"}},
+//	//     {Key: 1, Items: []string{"c"}},
+//	//     {Key: 3, Items: []string{"zig"}},
+//	//     {Key: 4, Items: []string{"rust"}},
+//	// }
+func GroupBySortedBySize[T any, K int | int8 | int16 | int32 | int64 | float32 | float64 | string](collection []T, keyFunc func(T) K) []struct {
+	Key   K
+	Items []T
+} {
+	groups := GroupBy(collection, keyFunc)
+
+	result := make([]struct {
+		Key   K
+		Items []T
+	}, 0, len(groups))
+	for key, items := range groups {
+		result = append(result, struct {
+			Key   K
+			Items []T
+		}{key, items})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].Items) != len(result[j].Items) {
+			return len(result[i].Items) > len(result[j].Items)
+		}
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+// ChunkByKey splits a collection into runs of consecutive elements that share the same key.
+// Unlike GroupBy, elements with the same key that are not adjacent end up in separate runs,
+// so the original sequence is preserved.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - keyFunc: The function that returns the key of each element
+//
+// Returns:
+//   - []struct{Key K; Items []T}: The runs, in their original order
+//
+// Example:
+//
+//	result := ChunkByKey([]string{"2024-01-01 a", "2024-01-01 b", "2024-01-02 c", "2024-01-01 d"}, func(s string) string { return s[:10] })
+//	// Returns: []struct{Key string; Items []string}{
+//	//     {Key: "2024-01-01", Items: []string{"2024-01-01 a", "2024-01-01 b"}},
+//	//     {Key: "2024-01-02", Items: []string{"2024-01-02 c"}},
+//	//     {Key: "2024-01-01", Items: []string{"2024-01-01 d"}},
+//	// }
+func ChunkByKey[T any, K comparable](collection []T, keyFunc func(T) K) []struct {
+	Key   K
+	Items []T
+} {
+	result := make([]struct {
+		Key   K
+		Items []T
+	}, 0)
+
+	for _, item := range collection {
+		key := keyFunc(item)
+		if last := len(result) - 1; last >= 0 && result[last].Key == key {
+			result[last].Items = append(result[last].Items, item)
+			continue
+		}
+		result = append(result, struct {
+			Key   K
+			Items []T
+		}{key, []T{item}})
+	}
+
+	return result
+}
+
 // Includes checks if a collection includes a specific value.
 //
 // Parameters:
@@ -221,6 +473,46 @@ func Includes[T comparable](collection []T, value T) bool {
 	return arr.Includes(collection, value)
 }
 
+// IndexOf returns the index of the first occurrence of a value in the collection.
+//
+// Parameters:
+//   - collection: The slice to search
+//   - value: The value to search for
+//
+// Returns:
+//   - int: The index of the first occurrence, or -1 if the value is not found
+//
+// Example:
+//
+//	IndexOf([]string{"a", "b", "a"}, "a")
+//	// Returns: 0
+//
+//	IndexOf([]int{1, 2, 3}, 4)
+//	// Returns: -1
+func IndexOf[T comparable](collection []T, value T) int {
+	return arr.IndexOf(collection, value)
+}
+
+// LastIndexOf returns the index of the last occurrence of a value in the collection.
+//
+// Parameters:
+//   - collection: The slice to search
+//   - value: The value to search for
+//
+// Returns:
+//   - int: The index of the last occurrence, or -1 if the value is not found
+//
+// Example:
+//
+//	LastIndexOf([]string{"a", "b", "a"}, "a")
+//	// Returns: 2
+//
+//	LastIndexOf([]int{1, 2, 3}, 4)
+//	// Returns: -1
+func LastIndexOf[T comparable](collection []T, value T) int {
+	return arr.LastIndexOf(collection, value)
+}
+
 // KeyBy creates an object composed of keys generated from the results of running each element of collection through iteratee.
 //
 // Parameters:
@@ -290,6 +582,35 @@ func MapWithIndex[T any, R any](collection []T, iteratee func(T, int) R) []R {
 	return result
 }
 
+// TransformStats maps and filters a collection in a single pass and reports how many
+// elements were kept and dropped. The function returns the mapped value and whether to keep it.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - fn: The function that maps each element and reports whether to keep the result
+//
+// Returns:
+//   - results: The mapped values that were kept
+//   - kept: The number of elements kept
+//   - dropped: The number of elements dropped
+//
+// Example:
+//
+//	results, kept, dropped := TransformStats([]string{"1", "x", "3"}, func(s string) (int, bool) {
+//	    n, err := strconv.Atoi(s)
+//	    return n, err == nil
+//	})
+//	// Returns: []int{1, 3}, 2, 1
+func TransformStats[T any, R any](collection []T, fn func(T) (R, bool)) (results []R, kept, dropped int) {
+	results = make([]R, 0, len(collection))
+	for _, item := range collection {
+		if value, ok := fn(item); ok {
+			results = append(results, value)
+		}
+	}
+	return results, len(results), len(collection) - len(results)
+}
+
 // Partition splits a collection into two groups, the first of which contains elements that satisfy the predicate.
 //
 // Parameters:
@@ -482,6 +803,29 @@ func Some[T any](collection []T, predicate func(T) bool) bool {
 	return false
 }
 
+// SomeWithIndex checks if any element in the collection satisfies the predicate,
+// which also receives the index of each element.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - predicate: The function that receives each element and its index
+//
+// Returns:
+//   - bool: True if any element satisfies the predicate, false otherwise
+//
+// Example:
+//
+//	SomeWithIndex([]int{3, 1, 2}, func(n int, i int) bool { return n == i })
+//	// Returns: true
+func SomeWithIndex[T any](collection []T, predicate func(T, int) bool) bool {
+	for i, item := range collection {
+		if predicate(item, i) {
+			return true
+		}
+	}
+	return false
+}
+
 // SortBy sorts a collection by the results of running each element through iteratee.
 // The sort is stable, meaning that elements with the same sort key maintain their
 // relative order from the original collection. This function creates a new slice
@@ -653,6 +997,39 @@ func FilterMap[K comparable, V any](collection map[K]V, predicate func(V, K) boo
 	return result
 }
 
+// MapEntries transforms both the keys and the values of a map.
+// If fn maps several entries to the same key, the last one written wins; since Go map
+// iteration order is random, which entry that is is unspecified.
+//
+// Parameters:
+//   - m: The map to transform
+//   - fn: The function that returns the new key and value for each entry
+//
+// Returns:
+//   - map[K2]V2: A new map containing the transformed entries
+//
+// Example:
+//
+//	// Lowercase keys and double values
+//	MapEntries(map[string]int{"A": 1, "B": 2}, func(k string, v int) (string, int) {
+//	    return strings.ToLower(k), v * 2
+//	})
+//	// Returns: map[string]int{"a": 2, "b": 4}
+//
+//	// Reindex by value
+//	MapEntries(map[string]int{"one": 1, "two": 2}, func(k string, v int) (int, string) {
+//	    return v, k
+//	})
+//	// Returns: map[int]string{1: "one", 2: "two"}
+func MapEntries[K1, K2 comparable, V1, V2 any](m map[K1]V1, fn func(K1, V1) (K2, V2)) map[K2]V2 {
+	result := make(map[K2]V2, len(m))
+	for k, v := range m {
+		key, value := fn(k, v)
+		result[key] = value
+	}
+	return result
+}
+
 // ReduceMap reduces a map to a value by iterating through the map and applying an accumulator function.
 // This function iterates over each key-value pair in the map and applies the iteratee
 // function to accumulate a single result. The order of iteration is not guaranteed
@@ -723,28 +1100,145 @@ func Avg[T any](collection []T, valueFunc func(T) float64) float64 {
 //   - size: The size of each chunk
 //
 // Returns:
-//   - [][]T: A slice of slices, each of the specified size (except possibly the last one)
+//   - [][]T: A slice of slices, each of the specified size (except possibly the last one),
+//     or an empty slice if size <= 0
 //
 // Example:
 //
 //	Chunk([]int{1, 2, 3, 4, 5}, 2)
 //	// Returns: [][]int{{1, 2}, {3, 4}, {5}}
 func Chunk[T any](collection []T, size int) [][]T {
+	return arr.Chunk(collection, size)
+}
+
+// ChunkWithIndex breaks the collection into chunks of the given size and calls fn with each
+// chunk and its zero-based chunk number, which is handy for progress reporting. Chunks share
+// memory with the collection, so fn should copy a batch if it keeps it beyond the call.
+//
+// Parameters:
+//   - collection: The slice to chunk
+//   - size: The size of each chunk; if size <= 0, fn is never called
+//   - fn: The function to invoke for each chunk
+//
+// Example:
+//
+//	ChunkWithIndex([]int{1, 2, 3, 4, 5}, 2, func(chunkIndex int, batch []int) {
+//	    fmt.Printf("batch %d: %v\n", chunkIndex, batch)
+//	})
+//	// Prints: "batch 0: [1 2]", "batch 1: [3 4]", "batch 2: [5]"
+func ChunkWithIndex[T any](collection []T, size int, fn func(chunkIndex int, batch []T)) {
 	if size <= 0 {
-		return [][]T{}
+		return
+	}
+
+	for i, chunkIndex := 0, 0; i < len(collection); i, chunkIndex = i+size, chunkIndex+1 {
+		end := min(i+size, len(collection))
+		fn(chunkIndex, collection[i:end:end])
+	}
+}
+
+// Iter returns an iterator over the elements of the collection, for use with range-over-func.
+// Elements are read lazily as the loop advances; nothing is copied up front.
+//
+// Parameters:
+//   - collection: The slice to iterate over
+//
+// Returns:
+//   - iter.Seq[T]: An iterator yielding each element in order
+//
+// Example:
+//
+//	for v := range Iter([]int{1, 2, 3}) {
+//	    fmt.Println(v)
+//	}
+//	// Prints: 1, 2, 3
+func Iter[T any](collection []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range collection {
+			if !yield(item) {
+				return
+			}
+		}
 	}
+}
 
-	chunks := make([][]T, 0, (len(collection)+size-1)/size)
+// ChunkedSeq returns an iterator over chunks of the given size, without building the whole
+// [][]T up front like Chunk does. No scratch buffer is reused: each chunk is a capped subslice
+// of the collection, so it shares memory with it but stays valid after the next iteration, and
+// appending to a chunk never overwrites the following elements. Breaking out of the range loop
+// stops the iteration immediately.
+//
+// Parameters:
+//   - collection: The slice to chunk
+//   - size: The size of each chunk; if size <= 0, nothing is yielded
+//
+// Returns:
+//   - iter.Seq[[]T]: An iterator yielding each chunk in order; the last one may be shorter
+//
+// Example:
+//
+//	for chunk := range ChunkedSeq([]int{1, 2, 3, 4, 5}, 2) {
+//	    fmt.Println(chunk)
+//	}
+//	// Prints: [1 2], [3 4], [5]
+func ChunkedSeq[T any](collection []T, size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
 
-	for i := 0; i < len(collection); i += size {
-		end := i + size
-		if end > len(collection) {
-			end = len(collection)
+		for i := 0; i < len(collection); i += size {
+			end := min(i+size, len(collection))
+			if !yield(collection[i:end:end]) {
+				return
+			}
 		}
-		chunks = append(chunks, collection[i:end])
 	}
+}
+
+// Window returns all overlapping windows of the given size, sliding one element at a time.
+// Like arr.Window, each window is a copy, so modifying a window never affects the
+// collection or the other windows.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - size: The number of elements in each window
+//
+// Returns:
+//   - [][]T: The windows in order, or an empty slice if size is not positive
+//     or larger than the collection
+//
+// Example:
+//
+//	Window([]int{1, 2, 3, 4}, 2)
+//	// Returns: [][]int{{1, 2}, {2, 3}, {3, 4}}
+//
+//	Window([]int{1, 2}, 3)
+//	// Returns: [][]int{}
+func Window[T any](collection []T, size int) [][]T {
+	return arr.Window(collection, size)
+}
 
-	return chunks
+// RollingReduce computes an aggregate over each sliding window of the given size,
+// such as a moving average or a rolling maximum.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - size: The number of elements in each window
+//   - reducer: The function that aggregates a window into a single value
+//
+// Returns:
+//   - []R: One aggregate per window, in order. Follows the same rules as Window,
+//     so it is empty if size is not positive or larger than the collection
+//
+// Example:
+//
+//	RollingReduce([]float64{1, 2, 3, 4}, 2, func(window []float64) float64 {
+//	    return (window[0] + window[1]) / 2
+//	})
+//	// Returns: []float64{1.5, 2.5, 3.5}
+func RollingReduce[T any, R any](collection []T, size int, reducer func(window []T) R) []R {
+	return Map(Window(collection, size), reducer)
 }
 
 // Collapse collapses a collection of arrays into a single, flat collection.
@@ -772,6 +1266,40 @@ func Collapse[T any](collection [][]T) []T {
 	return result
 }
 
+// Compact removes zero values from a collection.
+//
+// Parameters:
+//   - collection: The slice to compact
+//
+// Returns:
+//   - []T: A new slice with all zero values removed
+//
+// Example:
+//
+//	Compact([]string{"a", "", "b", ""})
+//	// Returns: []string{"a", "b"}
+func Compact[T comparable](collection []T) []T {
+	return arr.Compact(collection)
+}
+
+// CompactFunc removes the elements of a collection that the isEmpty function reports as empty.
+// Use it for types that are not comparable or have their own notion of emptiness.
+//
+// Parameters:
+//   - collection: The slice to compact
+//   - isEmpty: The function that returns true for elements to remove
+//
+// Returns:
+//   - []T: A new slice with all empty elements removed
+//
+// Example:
+//
+//	CompactFunc([][]int{{1}, {}, nil, {2, 3}}, func(s []int) bool { return len(s) == 0 })
+//	// Returns: [][]int{{1}, {2, 3}}
+func CompactFunc[T any](collection []T, isEmpty func(T) bool) []T {
+	return Reject(collection, isEmpty)
+}
+
 // Contains determines whether the collection contains a given item.
 //
 // Parameters:
@@ -1096,16 +1624,25 @@ func FlatMap[T any, R any](collection []T, callback func(T) []R) []R {
 //	Flatten([][]int{{1, 2}, {3, 4}})
 //	// Returns: []int{1, 2, 3, 4}
 func Flatten[T any](collection [][]T) []T {
-	totalLen := 0
-	for _, slice := range collection {
-		totalLen += len(slice)
-	}
+	return arr.Flatten(collection)
+}
 
-	result := make([]T, 0, totalLen)
-	for _, slice := range collection {
-		result = append(result, slice...)
-	}
-	return result
+// FlattenDeep recursively flattens nested slices and arrays within the collection.
+// Elements are visited depth-first, left to right; see arr.FlattenDeep for details,
+// including how self-referential slices are handled.
+//
+// Parameters:
+//   - collection: The slice to flatten, possibly containing nested slices
+//
+// Returns:
+//   - []any: A new slice containing the leaf values in order
+//
+// Example:
+//
+//	FlattenDeep([]any{1, []any{2, []any{3, []int{4}}}, "a"})
+//	// Returns: []any{1, 2, 3, 4, "a"}
+func FlattenDeep(collection []any) []any {
+	return arr.FlattenDeep(collection)
 }
 
 // Flip swaps the collection's keys with their corresponding values.
@@ -1483,6 +2020,133 @@ func Min[T any, V float64 | int | int64 | float32 | int32 | int16 | int8 | uint
 	return minVal
 }
 
+// MaxBy returns the element with the largest key, scanning the collection once.
+// When several elements share the largest key, the first one encountered wins.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - iteratee: The function that extracts the comparison key from each element
+//
+// Returns:
+//   - T: The element with the largest key, or the zero value if the collection is empty
+//   - bool: True if the collection was non-empty, false otherwise
+//
+// Example:
+//
+//	MaxBy([]struct{Name string; Age int}{{"Alice", 25}, {"Bob", 30}}, func(p struct{Name string; Age int}) int {
+//	    return p.Age
+//	})
+//	// Returns: {"Bob", 30}, true
+func MaxBy[T any, U int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string](collection []T, iteratee func(T) U) (T, bool) {
+	return extremeBy(collection, iteratee, func(a, b U) bool { return a > b })
+}
+
+// MinBy returns the element with the smallest key, scanning the collection once.
+// When several elements share the smallest key, the first one encountered wins.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - iteratee: The function that extracts the comparison key from each element
+//
+// Returns:
+//   - T: The element with the smallest key, or the zero value if the collection is empty
+//   - bool: True if the collection was non-empty, false otherwise
+//
+// Example:
+//
+//	MinBy([]string{"banana", "fig", "apple"}, func(s string) int { return len(s) })
+//	// Returns: "fig", true
+func MinBy[T any, U int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string](collection []T, iteratee func(T) U) (T, bool) {
+	return extremeBy(collection, iteratee, func(a, b U) bool { return a < b })
+}
+
+// MinMax returns both the smallest and the largest value of the collection, scanning it once
+// instead of once for each extreme.
+//
+// Parameters:
+//   - collection: The slice to process
+//
+// Returns:
+//   - min: The smallest value, or the zero value if the collection is empty
+//   - max: The largest value, or the zero value if the collection is empty
+//   - ok: True if the collection was non-empty, false otherwise
+//
+// Example:
+//
+//	MinMax([]int{3, 1, 4, 1, 5})
+//	// Returns: 1, 5, true
+//
+//	MinMax([]string{})
+//	// Returns: "", "", false
+func MinMax[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string](collection []T) (min, max T, ok bool) {
+	if len(collection) == 0 {
+		return min, max, false
+	}
+
+	min, max = collection[0], collection[0]
+	for _, item := range collection[1:] {
+		if item < min {
+			min = item
+		} else if item > max {
+			max = item
+		}
+	}
+	return min, max, true
+}
+
+// MinMaxBy returns the elements with the smallest and the largest key, scanning the collection
+// once. This is cheaper than calling MinBy and MaxBy separately, as the iteratee runs only once
+// per element. When several elements share the smallest or largest key, the first one
+// encountered wins.
+//
+// Parameters:
+//   - collection: The slice to process
+//   - iteratee: The function that extracts the comparison key from each element
+//
+// Returns:
+//   - min: The element with the smallest key, or the zero value if the collection is empty
+//   - max: The element with the largest key, or the zero value if the collection is empty
+//   - ok: True if the collection was non-empty, false otherwise
+//
+// Example:
+//
+//	MinMaxBy([]string{"banana", "fig", "apple"}, func(s string) int { return len(s) })
+//	// Returns: "fig", "banana", true
+func MinMaxBy[T any, U int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64 | string](collection []T, iteratee func(T) U) (min, max T, ok bool) {
+	if len(collection) == 0 {
+		return min, max, false
+	}
+
+	min, max = collection[0], collection[0]
+	minKey := iteratee(collection[0])
+	maxKey := minKey
+	for _, item := range collection[1:] {
+		key := iteratee(item)
+		if key < minKey {
+			min, minKey = item, key
+		} else if key > maxKey {
+			max, maxKey = item, key
+		}
+	}
+	return min, max, true
+}
+
+// extremeBy returns the first element whose key is not beaten by any other key according to better.
+func extremeBy[T any, U any](collection []T, iteratee func(T) U, better func(a, b U) bool) (T, bool) {
+	if len(collection) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	best, bestKey := collection[0], iteratee(collection[0])
+	for _, item := range collection[1:] {
+		if key := iteratee(item); better(key, bestKey) {
+			best, bestKey = item, key
+		}
+	}
+	return best, true
+}
+
 // Only returns the items in the collection with the specified keys.
 //
 // Parameters:
@@ -2171,6 +2835,57 @@ func UniqueBy[T any, K comparable](collection []T, keyFunc func(T) K) []T {
 	return result
 }
 
+// AllUnique checks if every element in the collection is distinct.
+// It stops at the first repeated element.
+//
+// Parameters:
+//   - collection: The slice to check
+//
+// Returns:
+//   - bool: True if no element appears more than once, false otherwise
+//
+// Example:
+//
+//	AllUnique([]int{1, 2, 3})
+//	// Returns: true
+//
+//	AllUnique([]string{"a", "b", "a"})
+//	// Returns: false
+func AllUnique[T comparable](collection []T) bool {
+	_, found := FirstDuplicate(collection)
+	return !found
+}
+
+// FirstDuplicate returns the first element that repeats an earlier element of the collection.
+// It stops as soon as the repeat is found.
+//
+// Parameters:
+//   - collection: The slice to check
+//
+// Returns:
+//   - T: The first repeated element, or the zero value if there is none
+//   - bool: True if a repeated element was found, false otherwise
+//
+// Example:
+//
+//	FirstDuplicate([]int{3, 1, 4, 1, 5, 3})
+//	// Returns: 1, true
+//
+//	FirstDuplicate([]int{1, 2, 3})
+//	// Returns: 0, false
+func FirstDuplicate[T comparable](collection []T) (T, bool) {
+	seen := make(map[T]struct{})
+	for _, item := range collection {
+		if _, ok := seen[item]; ok {
+			return item, true
+		}
+		seen[item] = struct{}{}
+	}
+
+	var zero T
+	return zero, false
+}
+
 // Unless executes the given callback when the condition is false.
 //
 // Parameters:
@@ -2264,6 +2979,26 @@ func Values[K comparable, V any](collection map[K]V) []V {
 	return values
 }
 
+// Entries returns all of the key/value pairs in the map collection.
+// The order of the pairs is not guaranteed.
+//
+// Parameters:
+//   - collection: The map to extract entries from
+//
+// Returns:
+//   - []struct{Key K; Value V}: A slice containing one pair per map entry
+//
+// Example:
+//
+//	Entries(map[string]int{"a": 1, "b": 2})
+//	// Returns: []struct{Key string; Value int}{{"a", 1}, {"b", 2}}
+func Entries[K comparable, V any](collection map[K]V) []struct {
+	Key   K
+	Value V
+} {
+	return arr.MapToSlice(collection)
+}
+
 // When executes the given callback when the condition is true.
 //
 // Parameters:
@@ -2474,3 +3209,65 @@ func Zip[T any](collection []T, arrays ...[]T) [][]T {
 
 	return result
 }
+
+// ZipWith combines two collections element by element using the combine function.
+// The result is truncated to the length of the shorter collection.
+//
+// Parameters:
+//   - a: The first slice
+//   - b: The second slice
+//   - combine: The function that merges the elements at the same position
+//
+// Returns:
+//   - []R: A new slice containing one combined value per position
+//
+// Example:
+//
+//	ZipWith([]int{1, 2, 3}, []string{"Alice", "Bob"}, func(id int, name string) string {
+//	    return fmt.Sprintf("%d:%s", id, name)
+//	})
+//	// Returns: []string{"1:Alice", "2:Bob"}
+func ZipWith[A any, B any, R any](a []A, b []B, combine func(A, B) R) []R {
+	length := min(len(a), len(b))
+
+	result := make([]R, length)
+	for i := 0; i < length; i++ {
+		result[i] = combine(a[i], b[i])
+	}
+	return result
+}
+
+// Interleave merges the given slices by taking one element from each in turn.
+// Unlike Zip, shorter slices do not truncate the result: once a slice is
+// exhausted it is skipped and the remaining slices continue until all are empty.
+//
+// Parameters:
+//   - slices: The slices to interleave
+//
+// Returns:
+//   - []T: A new slice containing every element of the inputs in round-robin order
+//
+// Example:
+//
+//	Interleave([]int{1, 2, 3}, []int{4, 5})
+//	// Returns: []int{1, 4, 2, 5, 3}
+//
+//	Interleave([]string{"a"}, []string{"b", "c"}, []string{"d"})
+//	// Returns: []string{"a", "b", "d", "c"}
+func Interleave[T any](slices ...[]T) []T {
+	total, longest := 0, 0
+	for _, slice := range slices {
+		total += len(slice)
+		longest = max(longest, len(slice))
+	}
+
+	result := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, slice := range slices {
+			if i < len(slice) {
+				result = append(result, slice[i])
+			}
+		}
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package col
 import (
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -48,6 +49,70 @@ func TestCountBy(t *testing.T) {
 	}
 }
 
+func TestCountByMulti(t *testing.T) {
+	type order struct {
+		Status string
+		Region string
+	}
+	orders := []order{{"paid", "eu"}, {"paid", "us"}, {"paid", "eu"}, {"refunded", "eu"}}
+	status := func(o order) string { return o.Status }
+	region := func(o order) string { return o.Region }
+
+	tests := []struct {
+		name     string
+		input    []order
+		keyFuncs []func(order) string
+		expected map[string]int
+	}{
+		{"status and region", orders, []func(order) string{status, region},
+			map[string]int{"paid|eu": 2, "paid|us": 1, "refunded|eu": 1}},
+		{"region and status", orders, []func(order) string{region, status},
+			map[string]int{"eu|paid": 2, "us|paid": 1, "eu|refunded": 1}},
+		{"single dimension", orders, []func(order) string{status},
+			map[string]int{"paid": 3, "refunded": 1}},
+		{"no key functions", orders, nil, map[string]int{}},
+		{"empty collection", []order{}, []func(order) string{status, region}, map[string]int{}},
+	}
+
+	for _, test := range tests {
+		result := CountByMulti(test.input, test.keyFuncs...)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("CountByMulti(%s) = %v, expected %v", test.name, result, test.expected)
+		}
+	}
+
+	// Non-string keys are formatted with fmt.Sprint
+	parity := CountByMulti([]int{1, 2, 3, 4, 5}, func(n int) int { return n % 2 }, func(n int) int { return n / 3 })
+	if !reflect.DeepEqual(parity, map[string]int{"1|0": 1, "0|0": 1, "1|1": 2, "0|1": 1}) {
+		t.Errorf("CountByMulti(ints) = %v", parity)
+	}
+}
+
+func TestTally(t *testing.T) {
+	type entry = struct {
+		Value string
+		Count int
+	}
+
+	tests := []struct {
+		input    []string
+		expected []entry
+	}{
+		{[]string{"b", "a", "c", "a", "b", "a"}, []entry{{"a", 3}, {"b", 2}, {"c", 1}}},
+		{[]string{"x", "y", "z", "y", "x"}, []entry{{"x", 2}, {"y", 2}, {"z", 1}}}, // Ties keep first appearance
+		{[]string{"c", "b", "a"}, []entry{{"c", 1}, {"b", 1}, {"a", 1}}},
+		{[]string{}, []entry{}},
+		{nil, []entry{}},
+	}
+
+	for _, test := range tests {
+		result := Tally(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Tally(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestEvery(t *testing.T) {
 	tests := []struct {
 		input     []int
@@ -79,6 +144,37 @@ func TestEvery(t *testing.T) {
 	}
 }
 
+func TestEveryWithIndex(t *testing.T) {
+	tests := []struct {
+		input     []int
+		predicate func(int, int) bool
+		expected  bool
+	}{
+		{
+			[]int{0, 1, 0, 1},
+			func(n int, i int) bool { return n == i%2 },
+			true,
+		},
+		{
+			[]int{0, 1, 1, 0},
+			func(n int, i int) bool { return n == i%2 },
+			false,
+		},
+		{
+			[]int{},
+			func(n int, i int) bool { return false },
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		result := EveryWithIndex(test.input, test.predicate)
+		if result != test.expected {
+			t.Errorf("EveryWithIndex(%v, func) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestFilter(t *testing.T) {
 	tests := []struct {
 		input     []int
@@ -145,6 +241,61 @@ func TestFind(t *testing.T) {
 	}
 }
 
+func TestFindWithIndex(t *testing.T) {
+	greaterThanTwo := func(n int) bool { return n > 2 }
+
+	tests := []struct {
+		input         []int
+		expected      int
+		expectedIndex int
+		expectedOk    bool
+	}{
+		{[]int{1, 2, 3, 4}, 3, 2, true},
+		{[]int{5, 1}, 5, 0, true},
+		{[]int{1, 2}, 0, -1, false},
+		{[]int{}, 0, -1, false},
+	}
+
+	for _, test := range tests {
+		result, index, ok := FindWithIndex(test.input, greaterThanTwo)
+		if result != test.expected || index != test.expectedIndex || ok != test.expectedOk {
+			t.Errorf("FindWithIndex(%v, greaterThanTwo) = (%v, %v, %v), expected (%v, %v, %v)",
+				test.input, result, index, ok, test.expected, test.expectedIndex, test.expectedOk)
+		}
+	}
+}
+
+func TestFindOrAppend(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "Alice"}, {2, "Bob"}}
+
+	// Hit: the existing element is returned and create is not called
+	created := false
+	result, found := FindOrAppend(users, func(u user) bool { return u.ID == 2 }, func() user {
+		created = true
+		return user{2, "New"}
+	})
+	if !reflect.DeepEqual(result, users) || found != (user{2, "Bob"}) || created {
+		t.Errorf("FindOrAppend hit = (%v, %v), created=%v, expected (%v, %v), created=false", result, found, created, users, user{2, "Bob"})
+	}
+
+	// Miss: a new element is created and appended
+	result, found = FindOrAppend(users, func(u user) bool { return u.ID == 3 }, func() user { return user{3, "Carol"} })
+	expected := []user{{1, "Alice"}, {2, "Bob"}, {3, "Carol"}}
+	if !reflect.DeepEqual(result, expected) || found != (user{3, "Carol"}) {
+		t.Errorf("FindOrAppend miss = (%v, %v), expected (%v, %v)", result, found, expected, user{3, "Carol"})
+	}
+
+	// Miss on an empty collection
+	ints, n := FindOrAppend([]int{}, func(n int) bool { return n > 0 }, func() int { return 7 })
+	if !reflect.DeepEqual(ints, []int{7}) || n != 7 {
+		t.Errorf("FindOrAppend on empty = (%v, %d), expected ([7], 7)", ints, n)
+	}
+}
+
 func TestFindLast(t *testing.T) {
 	tests := []struct {
 		input      []int
@@ -199,6 +350,46 @@ func TestIncludes(t *testing.T) {
 	}
 }
 
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		input    []string
+		value    string
+		expected int
+	}{
+		{[]string{"a", "b", "a"}, "a", 0},
+		{[]string{"a", "b", "a"}, "b", 1},
+		{[]string{"a", "b"}, "c", -1},
+		{[]string{}, "a", -1},
+	}
+
+	for _, test := range tests {
+		result := IndexOf(test.input, test.value)
+		if result != test.expected {
+			t.Errorf("IndexOf(%v, %q) = %d, expected %d", test.input, test.value, result, test.expected)
+		}
+	}
+}
+
+func TestLastIndexOf(t *testing.T) {
+	tests := []struct {
+		input    []string
+		value    string
+		expected int
+	}{
+		{[]string{"a", "b", "a"}, "a", 2},
+		{[]string{"a", "b", "a"}, "b", 1},
+		{[]string{"a", "b"}, "c", -1},
+		{[]string{}, "a", -1},
+	}
+
+	for _, test := range tests {
+		result := LastIndexOf(test.input, test.value)
+		if result != test.expected {
+			t.Errorf("LastIndexOf(%v, %q) = %d, expected %d", test.input, test.value, result, test.expected)
+		}
+	}
+}
+
 func TestSize(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -248,6 +439,37 @@ func TestSome(t *testing.T) {
 	}
 }
 
+func TestSomeWithIndex(t *testing.T) {
+	tests := []struct {
+		input     []int
+		predicate func(int, int) bool
+		expected  bool
+	}{
+		{
+			[]int{3, 1, 2},
+			func(n int, i int) bool { return n == i },
+			true,
+		},
+		{
+			[]int{1, 2, 3},
+			func(n int, i int) bool { return n == i },
+			false,
+		},
+		{
+			[]int{},
+			func(n int, i int) bool { return true },
+			false,
+		},
+	}
+
+	for _, test := range tests {
+		result := SomeWithIndex(test.input, test.predicate)
+		if result != test.expected {
+			t.Errorf("SomeWithIndex(%v, func) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestSortBy(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -402,6 +624,84 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestGroupBySortedBySize(t *testing.T) {
+	type group = struct {
+		Key   int
+		Items []string
+	}
+
+	tests := []struct {
+		input    []string
+		expected []group
+	}{
+		{
+			[]string{"go", "js", "rust", "c", "zig"},
+			[]group{
+				{2, []string{"go", "js"}},
+				{1, []string{"c"}},
+				{3, []string{"zig"}},
+				{4, []string{"rust"}},
+			},
+		},
+		{
+			[]string{"ab", "a", "b", "cd", "ef"},
+			[]group{
+				{2, []string{"ab", "cd", "ef"}},
+				{1, []string{"a", "b"}},
+			},
+		},
+		{
+			[]string{},
+			[]group{},
+		},
+	}
+
+	for _, test := range tests {
+		result := GroupBySortedBySize(test.input, func(s string) int { return len(s) })
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("GroupBySortedBySize(%v, func) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestChunkByKey(t *testing.T) {
+	type run = struct {
+		Key   bool
+		Items []int
+	}
+	isEven := func(n int) bool { return n%2 == 0 }
+
+	tests := []struct {
+		input    []int
+		expected []run
+	}{
+		{
+			[]int{2, 4, 1, 3, 6, 5},
+			[]run{
+				{true, []int{2, 4}},
+				{false, []int{1, 3}},
+				{true, []int{6}},
+				{false, []int{5}},
+			},
+		},
+		{
+			[]int{1, 3, 5},
+			[]run{{false, []int{1, 3, 5}}},
+		},
+		{
+			[]int{},
+			[]run{},
+		},
+	}
+
+	for _, test := range tests {
+		result := ChunkByKey(test.input, isEven)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ChunkByKey(%v, isEven) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestKeyBy(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -572,6 +872,33 @@ func TestMapWithIndex(t *testing.T) {
 	}
 }
 
+func TestTransformStats(t *testing.T) {
+	parse := func(s string) (int, bool) {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	}
+
+	tests := []struct {
+		input           []string
+		expected        []int
+		expectedKept    int
+		expectedDropped int
+	}{
+		{[]string{"1", "x", "3"}, []int{1, 3}, 2, 1},
+		{[]string{"a", "b"}, []int{}, 0, 2},
+		{[]string{"4", "5"}, []int{4, 5}, 2, 0},
+		{[]string{}, []int{}, 0, 0},
+	}
+
+	for _, test := range tests {
+		results, kept, dropped := TransformStats(test.input, parse)
+		if !reflect.DeepEqual(results, test.expected) || kept != test.expectedKept || dropped != test.expectedDropped {
+			t.Errorf("TransformStats(%v, func) = %v, %d, %d, expected %v, %d, %d",
+				test.input, results, kept, dropped, test.expected, test.expectedKept, test.expectedDropped)
+		}
+	}
+}
+
 func TestForEachWithIndex(t *testing.T) {
 	// Test that ForEachWithIndex calls the iteratee function for each element with the correct index
 	input := []int{10, 20, 30}
@@ -742,6 +1069,38 @@ func TestFilterMap(t *testing.T) {
 	}
 }
 
+func TestMapEntries(t *testing.T) {
+	lowerDouble := func(k string, v int) (string, int) { return strings.ToLower(k), v * 2 }
+
+	tests := []struct {
+		input    map[string]int
+		expected map[string]int
+	}{
+		{map[string]int{"A": 1, "B": 2}, map[string]int{"a": 2, "b": 4}},
+		{map[string]int{"a": 3}, map[string]int{"a": 6}},
+		{map[string]int{}, map[string]int{}},
+	}
+
+	for _, test := range tests {
+		result := MapEntries(test.input, lowerDouble)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("MapEntries(%v, lowerDouble) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	// Keys and values may change type
+	inverted := MapEntries(map[string]int{"one": 1, "two": 2}, func(k string, v int) (int, string) { return v, k })
+	if !reflect.DeepEqual(inverted, map[int]string{1: "one", 2: "two"}) {
+		t.Errorf("MapEntries invert = %v, expected %v", inverted, map[int]string{1: "one", 2: "two"})
+	}
+
+	// Colliding keys keep a single entry
+	collided := MapEntries(map[string]int{"a": 1, "A": 1}, lowerDouble)
+	if !reflect.DeepEqual(collided, map[string]int{"a": 2}) {
+		t.Errorf("MapEntries with collision = %v, expected %v", collided, map[string]int{"a": 2})
+	}
+}
+
 func TestReduceMap(t *testing.T) {
 	tests := []struct {
 		input       map[string]int
@@ -849,6 +1208,177 @@ func TestChunk(t *testing.T) {
 	}
 }
 
+func TestChunkWithIndex(t *testing.T) {
+	tests := []struct {
+		input           []int
+		size            int
+		expectedIndexes []int
+		expectedBatches [][]int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, []int{0, 1, 2}, [][]int{{1, 2}, {3, 4}, {5}}},
+		{[]int{1, 2, 3}, 3, []int{0}, [][]int{{1, 2, 3}}},
+		{[]int{1, 2, 3}, 5, []int{0}, [][]int{{1, 2, 3}}},
+		{[]int{}, 2, []int{}, [][]int{}},
+		{[]int{1, 2, 3}, 0, []int{}, [][]int{}},
+	}
+
+	for _, test := range tests {
+		indexes := []int{}
+		batches := [][]int{}
+		ChunkWithIndex(test.input, test.size, func(chunkIndex int, batch []int) {
+			indexes = append(indexes, chunkIndex)
+			batches = append(batches, batch)
+		})
+		if !reflect.DeepEqual(indexes, test.expectedIndexes) || !reflect.DeepEqual(batches, test.expectedBatches) {
+			t.Errorf("ChunkWithIndex(%v, %d) produced %v %v, expected %v %v",
+				test.input, test.size, indexes, batches, test.expectedIndexes, test.expectedBatches)
+		}
+	}
+}
+
+func TestIter(t *testing.T) {
+	tests := []struct {
+		input    []int
+		expected []int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{}, []int{}},
+		{nil, []int{}},
+	}
+
+	for _, test := range tests {
+		result := []int{}
+		for v := range Iter(test.input) {
+			result = append(result, v)
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Iter(%v) yielded %v, expected %v", test.input, result, test.expected)
+		}
+	}
+
+	// Breaking out of the loop stops the iteration
+	result := []int{}
+	for v := range Iter([]int{1, 2, 3, 4}) {
+		if v == 3 {
+			break
+		}
+		result = append(result, v)
+	}
+	if !reflect.DeepEqual(result, []int{1, 2}) {
+		t.Errorf("Iter with break yielded %v, expected [1 2]", result)
+	}
+}
+
+func TestChunkedSeq(t *testing.T) {
+	tests := []struct {
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{[]int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{[]int{1, 2, 3}, 5, [][]int{{1, 2, 3}}},
+		{[]int{}, 2, [][]int{}},
+		{[]int{1, 2, 3}, 0, [][]int{}},
+		{[]int{1, 2, 3}, -1, [][]int{}},
+	}
+
+	for _, test := range tests {
+		result := [][]int{}
+		for chunk := range ChunkedSeq(test.input, test.size) {
+			result = append(result, chunk)
+		}
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ChunkedSeq(%v, %d) yielded %v, expected %v", test.input, test.size, result, test.expected)
+		}
+	}
+
+	// Breaking out of the loop stops the iteration
+	calls := 0
+	for range ChunkedSeq([]int{1, 2, 3, 4, 5, 6}, 2) {
+		calls++
+		break
+	}
+	if calls != 1 {
+		t.Errorf("ChunkedSeq with break yielded %d chunks, expected 1", calls)
+	}
+
+	// Appending to a chunk must not overwrite the following elements
+	input := []int{1, 2, 3, 4}
+	for chunk := range ChunkedSeq(input, 2) {
+		_ = append(chunk, 99)
+	}
+	if !reflect.DeepEqual(input, []int{1, 2, 3, 4}) {
+		t.Errorf("Appending to a chunk modified the collection: %v", input)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{[]int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{[]int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{[]int{1, 2, 3}, 1, [][]int{{1}, {2}, {3}}},
+		{[]int{1, 2}, 3, [][]int{}},
+		{[]int{1, 2}, 0, [][]int{}},
+		{[]int{1, 2}, -1, [][]int{}},
+		{[]int{}, 2, [][]int{}},
+	}
+
+	for _, test := range tests {
+		result := Window(test.input, test.size)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Window(%v, %d) = %v, expected %v", test.input, test.size, result, test.expected)
+		}
+	}
+
+	// Windows are copies: modifying or appending to one must not affect the input or its neighbours
+	input := []int{1, 2, 3}
+	windows := Window(input, 2)
+	windows[0][1] = 20
+	_ = append(windows[0], 99)
+	if !reflect.DeepEqual(input, []int{1, 2, 3}) {
+		t.Errorf("modifying a window changed the input: %v", input)
+	}
+	if !reflect.DeepEqual(windows[1], []int{2, 3}) {
+		t.Errorf("modifying a window changed its neighbour: %v", windows[1])
+	}
+}
+
+func TestRollingReduce(t *testing.T) {
+	movingAverage := func(window []float64) float64 {
+		return Avg(window, func(v float64) float64 { return v })
+	}
+
+	tests := []struct {
+		input    []float64
+		size     int
+		expected []float64
+	}{
+		{[]float64{1, 2, 3, 4}, 2, []float64{1.5, 2.5, 3.5}},
+		{[]float64{2, 4, 6}, 3, []float64{4}},
+		{[]float64{1, 2}, 3, []float64{}},
+		{[]float64{}, 1, []float64{}},
+	}
+
+	for _, test := range tests {
+		result := RollingReduce(test.input, test.size, movingAverage)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("RollingReduce(%v, %d, movingAverage) = %v, expected %v", test.input, test.size, result, test.expected)
+		}
+	}
+
+	rollingMax := RollingReduce([]int{1, 3, 2, 5, 4}, 3, func(window []int) int {
+		return Max(window, func(v int) int { return v })
+	})
+	if !reflect.DeepEqual(rollingMax, []int{3, 5, 5}) {
+		t.Errorf("RollingReduce(rollingMax) = %v, expected %v", rollingMax, []int{3, 5, 5})
+	}
+}
+
 func TestContains(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -983,6 +1513,42 @@ func TestCollapse(t *testing.T) {
 	}
 }
 
+func TestCompact(t *testing.T) {
+	tests := []struct {
+		input    []string
+		expected []string
+	}{
+		{[]string{"a", "", "b", ""}, []string{"a", "b"}},
+		{[]string{"", ""}, []string{}},
+		{[]string{}, []string{}},
+	}
+
+	for _, test := range tests {
+		result := Compact(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Compact(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestCompactFunc(t *testing.T) {
+	tests := []struct {
+		input    [][]int
+		expected [][]int
+	}{
+		{[][]int{{1}, {}, nil, {2, 3}}, [][]int{{1}, {2, 3}}},
+		{[][]int{{}, nil}, [][]int{}},
+		{[][]int{}, [][]int{}},
+	}
+
+	for _, test := range tests {
+		result := CompactFunc(test.input, func(s []int) bool { return len(s) == 0 })
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("CompactFunc(%v, func) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestCrossJoin(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -1230,6 +1796,26 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestFlattenDeep(t *testing.T) {
+	tests := []struct {
+		input    []any
+		expected []any
+	}{
+		{[]any{1, []any{2, []any{3, []int{4}}}, "a"}, []any{1, 2, 3, 4, "a"}},
+		{[]any{[]any{}, []string{"x", "y"}}, []any{"x", "y"}},
+		{[]any{1, 2}, []any{1, 2}},
+		{[]any{}, []any{}},
+		{nil, []any{}},
+	}
+
+	for _, test := range tests {
+		result := FlattenDeep(test.input)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("FlattenDeep(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestFlip(t *testing.T) {
 	tests := []struct {
 		input    map[string]int
@@ -1655,6 +2241,137 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMaxBy(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+
+	tests := []struct {
+		input      []person
+		expected   person
+		expectedOk bool
+	}{
+		{[]person{{"Alice", 25}, {"Bob", 30}, {"Carol", 20}}, person{"Bob", 30}, true},
+		{[]person{{"Alice", 30}, {"Bob", 30}}, person{"Alice", 30}, true}, // First wins on ties
+		{[]person{{"Alice", 25}}, person{"Alice", 25}, true},
+		{[]person{}, person{}, false},
+	}
+
+	for _, test := range tests {
+		result, ok := MaxBy(test.input, func(p person) int { return p.Age })
+		if result != test.expected || ok != test.expectedOk {
+			t.Errorf("MaxBy(%v, age) = (%v, %v), expected (%v, %v)", test.input, result, ok, test.expected, test.expectedOk)
+		}
+	}
+
+	// String keys
+	if result, _ := MaxBy([]string{"pear", "apple", "zucchini"}, func(s string) string { return s }); result != "zucchini" {
+		t.Errorf("MaxBy with string keys = %q, expected %q", result, "zucchini")
+	}
+
+	// Unsigned keys
+	if result, _ := MaxBy([]person{{"Alice", 25}, {"Bob", 30}}, func(p person) uint64 { return uint64(p.Age) }); result.Name != "Bob" {
+		t.Errorf("MaxBy with uint64 keys = %v, expected %v", result, person{"Bob", 30})
+	}
+}
+
+func TestMinBy(t *testing.T) {
+	byLength := func(s string) int { return len(s) }
+
+	tests := []struct {
+		input      []string
+		expected   string
+		expectedOk bool
+	}{
+		{[]string{"banana", "fig", "apple"}, "fig", true},
+		{[]string{"kiwi", "pear", "plum"}, "kiwi", true}, // First wins on ties
+		{[]string{"a"}, "a", true},
+		{[]string{}, "", false},
+	}
+
+	for _, test := range tests {
+		result, ok := MinBy(test.input, byLength)
+		if result != test.expected || ok != test.expectedOk {
+			t.Errorf("MinBy(%v, byLength) = (%q, %v), expected (%q, %v)", test.input, result, ok, test.expected, test.expectedOk)
+		}
+	}
+
+	// Float keys
+	if result, _ := MinBy([]float64{2.5, -1.5, 0}, func(f float64) float64 { return f }); result != -1.5 {
+		t.Errorf("MinBy with float keys = %v, expected %v", result, -1.5)
+	}
+
+	// Unsigned keys
+	if result, _ := MinBy([]string{"banana", "fig", "apple"}, func(s string) uint { return uint(len(s)) }); result != "fig" {
+		t.Errorf("MinBy with uint keys = %q, expected %q", result, "fig")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		input       []int
+		expectedMin int
+		expectedMax int
+		expectedOk  bool
+	}{
+		{[]int{3, 1, 4, 1, 5}, 1, 5, true},
+		{[]int{5, 4, 3, 2, 1}, 1, 5, true},
+		{[]int{-2, -7, 0}, -7, 0, true},
+		{[]int{42}, 42, 42, true},
+		{[]int{}, 0, 0, false},
+		{nil, 0, 0, false},
+	}
+
+	for _, test := range tests {
+		minVal, maxVal, ok := MinMax(test.input)
+		if minVal != test.expectedMin || maxVal != test.expectedMax || ok != test.expectedOk {
+			t.Errorf("MinMax(%v) = (%v, %v, %v), expected (%v, %v, %v)",
+				test.input, minVal, maxVal, ok, test.expectedMin, test.expectedMax, test.expectedOk)
+		}
+	}
+
+	// String values
+	if minVal, maxVal, _ := MinMax([]string{"pear", "apple", "zucchini"}); minVal != "apple" || maxVal != "zucchini" {
+		t.Errorf("MinMax with strings = (%q, %q), expected (%q, %q)", minVal, maxVal, "apple", "zucchini")
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	byLength := func(s string) int { return len(s) }
+
+	tests := []struct {
+		input       []string
+		expectedMin string
+		expectedMax string
+		expectedOk  bool
+	}{
+		{[]string{"banana", "fig", "apple"}, "fig", "banana", true},
+		{[]string{"kiwi", "pear", "plum"}, "kiwi", "kiwi", true}, // First wins on ties
+		{[]string{"ab", "cd", "e", "f", "gh"}, "e", "ab", true},
+		{[]string{"a"}, "a", "a", true},
+		{[]string{}, "", "", false},
+	}
+
+	for _, test := range tests {
+		minVal, maxVal, ok := MinMaxBy(test.input, byLength)
+		if minVal != test.expectedMin || maxVal != test.expectedMax || ok != test.expectedOk {
+			t.Errorf("MinMaxBy(%v, byLength) = (%q, %q, %v), expected (%q, %q, %v)",
+				test.input, minVal, maxVal, ok, test.expectedMin, test.expectedMax, test.expectedOk)
+		}
+	}
+
+	// Unsigned keys
+	type item struct {
+		Name string
+		Size uint64
+	}
+	items := []item{{"b", 20}, {"a", 5}, {"c", 40}}
+	if minItem, maxItem, _ := MinMaxBy(items, func(i item) uint64 { return i.Size }); minItem.Name != "a" || maxItem.Name != "c" {
+		t.Errorf("MinMaxBy with uint64 keys = (%v, %v), expected ({a 5}, {c 40})", minItem, maxItem)
+	}
+}
+
 func TestOnly(t *testing.T) {
 	tests := []struct {
 		input    map[string]int
@@ -2412,6 +3129,51 @@ func TestUniqueBy(t *testing.T) {
 	}
 }
 
+func TestAllUnique(t *testing.T) {
+	large := make([]int, 100000)
+	for i := range large {
+		large[i] = i
+	}
+
+	tests := []struct {
+		input    []int
+		expected bool
+	}{
+		{[]int{1, 2, 3}, true},
+		{[]int{1, 2, 1}, false},
+		{[]int{}, true},
+		{large, true},
+		{append([]int{7, 7}, large...), false}, // Early duplicate in a large slice
+	}
+
+	for _, test := range tests {
+		result := AllUnique(test.input)
+		if result != test.expected {
+			t.Errorf("AllUnique(%d elements) = %v, expected %v", len(test.input), result, test.expected)
+		}
+	}
+}
+
+func TestFirstDuplicate(t *testing.T) {
+	tests := []struct {
+		input         []int
+		expected      int
+		expectedFound bool
+	}{
+		{[]int{3, 1, 4, 1, 5, 3}, 1, true},
+		{[]int{2, 2}, 2, true},
+		{[]int{1, 2, 3}, 0, false},
+		{[]int{}, 0, false},
+	}
+
+	for _, test := range tests {
+		result, found := FirstDuplicate(test.input)
+		if result != test.expected || found != test.expectedFound {
+			t.Errorf("FirstDuplicate(%v) = %d, %v, expected %d, %v", test.input, result, found, test.expected, test.expectedFound)
+		}
+	}
+}
+
 func TestValues(t *testing.T) {
 	tests := []struct {
 		input    map[string]int
@@ -2439,6 +3201,36 @@ func TestValues(t *testing.T) {
 	}
 }
 
+func TestEntries(t *testing.T) {
+	type entry = struct {
+		Key   string
+		Value int
+	}
+
+	tests := []struct {
+		input    map[string]int
+		expected []entry
+	}{
+		{
+			map[string]int{"a": 1, "b": 2, "c": 3},
+			[]entry{{"a", 1}, {"b", 2}, {"c", 3}},
+		},
+		{
+			map[string]int{},
+			[]entry{},
+		},
+	}
+
+	for _, test := range tests {
+		result := Entries(test.input)
+		// Sort the result by key for deterministic comparison
+		sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Entries(%v) = %v, expected %v", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestZip(t *testing.T) {
 	tests := []struct {
 		input    []int
@@ -2475,6 +3267,48 @@ func TestZip(t *testing.T) {
 	}
 }
 
+func TestZipWith(t *testing.T) {
+	label := func(id int, name string) string { return strconv.Itoa(id) + ":" + name }
+
+	tests := []struct {
+		ids      []int
+		names    []string
+		expected []string
+	}{
+		{[]int{1, 2}, []string{"Alice", "Bob"}, []string{"1:Alice", "2:Bob"}},
+		{[]int{1, 2, 3}, []string{"Alice", "Bob"}, []string{"1:Alice", "2:Bob"}},
+		{[]int{1}, []string{"Alice", "Bob"}, []string{"1:Alice"}},
+		{[]int{}, []string{"Alice"}, []string{}},
+	}
+
+	for _, test := range tests {
+		result := ZipWith(test.ids, test.names, label)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ZipWith(%v, %v, label) = %v, expected %v", test.ids, test.names, result, test.expected)
+		}
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		slices   [][]int
+		expected []int
+	}{
+		{[][]int{{1, 2, 3}, {4, 5}}, []int{1, 4, 2, 5, 3}},
+		{[][]int{{1}, {2, 3, 4}, {5, 6}}, []int{1, 2, 5, 3, 6, 4}},
+		{[][]int{{}, {1, 2}}, []int{1, 2}},
+		{[][]int{{1, 2}}, []int{1, 2}},
+		{[][]int{}, []int{}},
+	}
+
+	for _, test := range tests {
+		result := Interleave(test.slices...)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("Interleave(%v) = %v, expected %v", test.slices, result, test.expected)
+		}
+	}
+}
+
 func TestUnless(t *testing.T) {
 	tests := []struct {
 		input     []int
@@ -2823,3 +3657,27 @@ func TestEach(t *testing.T) {
 		t.Errorf("Each(%v, func) processed %d elements, expected %d", emptyInput, emptyCount, 0)
 	}
 }
+
+func BenchmarkAllUniqueEarlyDuplicate(b *testing.B) {
+	collection := make([]int, 100000)
+	for i := range collection {
+		collection[i] = i
+	}
+	collection[1] = collection[0]
+
+	for i := 0; i < b.N; i++ {
+		_ = AllUnique(collection)
+	}
+}
+
+func BenchmarkUniqueEarlyDuplicate(b *testing.B) {
+	collection := make([]int, 100000)
+	for i := range collection {
+		collection[i] = i
+	}
+	collection[1] = collection[0]
+
+	for i := 0; i < b.N; i++ {
+		_ = len(Unique(collection)) == len(collection)
+	}
+}
@@ -32,6 +32,52 @@ func Clamp(n, lower, upper float64) float64 {
 	return math.Min(math.Max(n, lower), upper)
 }
 
+// ClampOf is the generic form of Clamp for any integer or float type.
+// Like Clamp, it swaps the bounds when lower > upper instead of panicking.
+//
+// Parameters:
+//   - n: The number to clamp
+//   - lower: The lower bound
+//   - upper: The upper bound
+//
+// Returns:
+//   - T: The clamped value
+//
+// Examples:
+//
+//	ClampOf(150, 0, 100)    // Returns 100
+//	ClampOf(int8(-3), 0, 5) // Returns 0
+//	ClampOf(7, 10, 0)       // Returns 7 (lower > upper, bounds are swapped)
+func ClampOf[T float64 | int | int64 | float32 | int32 | int16 | int8 | uint | uint64 | uint32 | uint16 | uint8](n, lower, upper T) T {
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+	return min(max(n, lower), upper)
+}
+
+// ClampSlice returns a new slice with every number clamped between lower and upper.
+// The bounds are swapped when lower > upper, as in Clamp.
+//
+// Parameters:
+//   - numbers: The numbers to clamp
+//   - lower: The lower bound
+//   - upper: The upper bound
+//
+// Returns:
+//   - []T: A new slice of clamped values
+//
+// Examples:
+//
+//	ClampSlice([]int{-5, 50, 120}, 0, 100) // Returns []int{0, 50, 100}
+//	ClampSlice([]float64{0.5, 1.5}, 0, 1)  // Returns []float64{0.5, 1}
+func ClampSlice[T float64 | int | int64 | float32 | int32 | int16 | int8 | uint | uint64 | uint32 | uint16 | uint8](numbers []T, lower, upper T) []T {
+	result := make([]T, len(numbers))
+	for i, n := range numbers {
+		result[i] = ClampOf(n, lower, upper)
+	}
+	return result
+}
+
 // InRange checks if a number is between start and end (inclusive).
 //
 // Parameters:
@@ -160,6 +206,92 @@ func Ceil(n float64, precision ...int) float64 {
 	return math.Ceil(n*p) / p
 }
 
+// RoundTo rounds a number half away from zero to the given number of decimal places.
+// Unlike Round, the scaling is done on the shortest decimal representation of the number,
+// so values such as 2.345 or 0.285, which are stored slightly below their written value,
+// still round up as written. Negative places round to tens, hundreds and so on.
+//
+// Parameters:
+//   - value: The number to round
+//   - places: The number of decimal places to keep; negative values round left of the decimal point
+//
+// Returns:
+//   - float64: The rounded number
+//
+// Examples:
+//
+//	RoundTo(2.345, 2)   // Returns 2.35
+//	RoundTo(0.285, 2)   // Returns 0.29
+//	RoundTo(-2.345, 2)  // Returns -2.35 (half away from zero)
+//	RoundTo(1234.5, -2) // Returns 1200
+func RoundTo(value float64, places int) float64 {
+	return roundToPlaces(value, places, math.Round)
+}
+
+// FloorTo rounds a number down to the given number of decimal places.
+// Like RoundTo, it works on the shortest decimal representation of the number,
+// so FloorTo(0.29, 2) stays 0.29 instead of dropping to 0.28.
+//
+// Parameters:
+//   - value: The number to round down
+//   - places: The number of decimal places to keep; negative values round left of the decimal point
+//
+// Returns:
+//   - float64: The rounded down number
+//
+// Examples:
+//
+//	FloorTo(2.349, 2)   // Returns 2.34
+//	FloorTo(-2.341, 2)  // Returns -2.35
+//	FloorTo(1299.0, -2) // Returns 1200
+func FloorTo(value float64, places int) float64 {
+	return roundToPlaces(value, places, math.Floor)
+}
+
+// CeilTo rounds a number up to the given number of decimal places.
+// Like RoundTo, it works on the shortest decimal representation of the number,
+// so CeilTo(1.1, 1) stays 1.1 instead of rising to 1.2.
+//
+// Parameters:
+//   - value: The number to round up
+//   - places: The number of decimal places to keep; negative values round left of the decimal point
+//
+// Returns:
+//   - float64: The rounded up number
+//
+// Examples:
+//
+//	CeilTo(2.341, 2)   // Returns 2.35
+//	CeilTo(-2.349, 2)  // Returns -2.34
+//	CeilTo(1201.0, -2) // Returns 1300
+func CeilTo(value float64, places int) float64 {
+	return roundToPlaces(value, places, math.Ceil)
+}
+
+// roundToPlaces applies an integer rounding function at the given decimal place. The value is
+// scaled by moving the exponent of its shortest decimal representation rather than by
+// multiplying by a power of ten, which would introduce a second representation error.
+func roundToPlaces(value float64, places int, round func(float64) float64) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+
+	scaled := shiftDecimal(value, places)
+	if math.IsInf(scaled, 0) {
+		// More places than a float64 can hold, so there is nothing to round
+		return value
+	}
+	return shiftDecimal(round(scaled), -places)
+}
+
+// shiftDecimal returns value * 10^places, computed exactly on the decimal digits of value.
+func shiftDecimal(value float64, places int) float64 {
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(value, 'e', -1, 64), "e")
+	exp, _ := strconv.Atoi(exponent)
+	result, _ := strconv.ParseFloat(mantissa+"e"+strconv.Itoa(exp+places), 64)
+	return result
+}
+
 // Max returns the maximum value from a list of numbers.
 //
 // Parameters:
@@ -27,6 +27,62 @@ func TestClamp(t *testing.T) {
 	}
 }
 
+func TestClampOf(t *testing.T) {
+	intTests := []struct {
+		n        int
+		lower    int
+		upper    int
+		expected int
+	}{
+		{150, 0, 100, 100},
+		{-5, 0, 100, 0},
+		{42, 0, 100, 42},
+		{7, 10, 0, 7}, // lower > upper, should swap
+		{12, 10, 0, 10},
+	}
+
+	for _, test := range intTests {
+		result := ClampOf(test.n, test.lower, test.upper)
+		if result != test.expected {
+			t.Errorf("ClampOf(%d, %d, %d) = %d, expected %d", test.n, test.lower, test.upper, result, test.expected)
+		}
+	}
+
+	if result := ClampOf(uint8(200), 10, 100); result != 100 {
+		t.Errorf("ClampOf(uint8(200), 10, 100) = %d, expected 100", result)
+	}
+	if result := ClampOf(-0.5, 0, 1); result != 0 {
+		t.Errorf("ClampOf(-0.5, 0, 1) = %f, expected 0", result)
+	}
+}
+
+func TestClampSlice(t *testing.T) {
+	tests := []struct {
+		input    []int
+		lower    int
+		upper    int
+		expected []int
+	}{
+		{[]int{-5, 50, 120}, 0, 100, []int{0, 50, 100}},
+		{[]int{-5, 50, 120}, 100, 0, []int{0, 50, 100}}, // lower > upper, should swap
+		{[]int{}, 0, 100, []int{}},
+	}
+
+	for _, test := range tests {
+		result := ClampSlice(test.input, test.lower, test.upper)
+		if !reflect.DeepEqual(result, test.expected) {
+			t.Errorf("ClampSlice(%v, %d, %d) = %v, expected %v", test.input, test.lower, test.upper, result, test.expected)
+		}
+	}
+
+	// The input is not modified
+	input := []float64{1.5, -1}
+	ClampSlice(input, 0, 1)
+	if !reflect.DeepEqual(input, []float64{1.5, -1}) {
+		t.Errorf("ClampSlice modified its input: %v", input)
+	}
+}
+
 func TestInRange(t *testing.T) {
 	tests := []struct {
 		n        float64
@@ -120,6 +176,92 @@ func TestCeil(t *testing.T) {
 	}
 }
 
+func TestRoundTo(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		expected float64
+	}{
+		{2.345, 2, 2.35},
+		{0.285, 2, 0.29}, // 0.285 is stored as 0.28499999999999998
+		{1.005, 2, 1.01},
+		{1.255, 2, 1.26},
+		{-2.345, 2, -2.35}, // Half away from zero
+		{-0.285, 2, -0.29},
+		{2.344, 2, 2.34},
+		{2.5, 0, 3},
+		{-2.5, 0, -3},
+		{1234.5, -2, 1200},
+		{1250, -2, 1300},
+		{-1250, -2, -1300},
+		{49, -2, 0},
+		{1.23456789, 4, 1.2346},
+		{0.1, 20, 0.1},
+		{1e300, 100, 1e300}, // Too many places to scale; returned unchanged
+		{0, 2, 0},
+	}
+
+	for _, test := range tests {
+		result := RoundTo(test.value, test.places)
+		if result != test.expected {
+			t.Errorf("RoundTo(%v, %d) = %v, expected %v", test.value, test.places, result, test.expected)
+		}
+	}
+
+	if result := RoundTo(math.NaN(), 2); !math.IsNaN(result) {
+		t.Errorf("RoundTo(NaN, 2) = %v, expected NaN", result)
+	}
+	if result := RoundTo(math.Inf(1), 2); !math.IsInf(result, 1) {
+		t.Errorf("RoundTo(+Inf, 2) = %v, expected +Inf", result)
+	}
+}
+
+func TestFloorTo(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		expected float64
+	}{
+		{2.349, 2, 2.34},
+		{0.29, 2, 0.29}, // Naive scaling gives 0.28
+		{4.35, 2, 4.35},
+		{-2.341, 2, -2.35},
+		{1299, -2, 1200},
+		{-1201, -2, -1300},
+		{2.7, 0, 2},
+	}
+
+	for _, test := range tests {
+		result := FloorTo(test.value, test.places)
+		if result != test.expected {
+			t.Errorf("FloorTo(%v, %d) = %v, expected %v", test.value, test.places, result, test.expected)
+		}
+	}
+}
+
+func TestCeilTo(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		expected float64
+	}{
+		{2.341, 2, 2.35},
+		{1.1, 1, 1.1}, // Naive scaling gives 1.2
+		{0.07, 2, 0.07},
+		{-2.349, 2, -2.34},
+		{1201, -2, 1300},
+		{-1299, -2, -1200},
+		{2.1, 0, 3},
+	}
+
+	for _, test := range tests {
+		result := CeilTo(test.value, test.places)
+		if result != test.expected {
+			t.Errorf("CeilTo(%v, %d) = %v, expected %v", test.value, test.places, result, test.expected)
+		}
+	}
+}
+
 func TestMax(t *testing.T) {
 	tests := []struct {
 		numbers  []float64
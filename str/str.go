@@ -4,13 +4,29 @@ package str
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand/v2"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"unicode"
 	"unicode/utf8"
 )
 
+// Precompiled regular expressions shared by the case conversion and cleanup helpers.
+var (
+	wordRegex            = regexp.MustCompile(`[A-Z]*[a-z]+|[A-Z]+[a-z]*|\d+|[a-z]+`)
+	nonKebabRegex        = regexp.MustCompile("[^a-z0-9-]")
+	multiHyphenRegex     = regexp.MustCompile("-+")
+	nonSnakeRegex        = regexp.MustCompile("[^a-z0-9_]")
+	multiUnderscoreRegex = regexp.MustCompile("_+")
+	nonAlphanumericRegex = regexp.MustCompile("[^a-zA-Z0-9]")
+	whitespaceRegex      = regexp.MustCompile(`\s+`)
+)
+
 // ToString converts any value to its string representation.
 //
 // Parameters:
@@ -28,6 +44,76 @@ func ToString[T any](value T) string {
 	return fmt.Sprintf("%v", value)
 }
 
+// BoolOr parses a string as a boolean, returning def if it cannot be parsed.
+// Surrounding whitespace is ignored and the accepted values are those of strconv.ParseBool.
+//
+// Parameters:
+//   - s: The string to parse
+//   - def: The value to return if s is not a valid boolean
+//
+// Returns:
+//   - bool: The parsed boolean, or def on failure
+//
+// Example:
+//
+//	BoolOr("true", false) -> true
+//	BoolOr(" 0 ", true) -> false
+//	BoolOr("yes", false) -> false (not a valid boolean)
+func BoolOr(s string, def bool) bool {
+	value, err := strconv.ParseBool(strings.TrimSpace(s))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// IntOr parses a string as a base 10 integer, returning def if it cannot be parsed.
+// Surrounding whitespace is ignored.
+//
+// Parameters:
+//   - s: The string to parse
+//   - def: The value to return if s is not a valid integer
+//
+// Returns:
+//   - int: The parsed integer, or def on failure
+//
+// Example:
+//
+//	IntOr("42", 0) -> 42
+//	IntOr("-7", 0) -> -7
+//	IntOr("4.2", 10) -> 10 (not an integer)
+//	IntOr("", 10) -> 10
+func IntOr(s string, def int) int {
+	value, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// FloatOr parses a string as a floating point number, returning def if it cannot be parsed.
+// Surrounding whitespace is ignored.
+//
+// Parameters:
+//   - s: The string to parse
+//   - def: The value to return if s is not a valid number
+//
+// Returns:
+//   - float64: The parsed number, or def on failure
+//
+// Example:
+//
+//	FloatOr("3.14", 0) -> 3.14
+//	FloatOr("1e3", 0) -> 1000
+//	FloatOr("abc", 1.5) -> 1.5
+func FloatOr(s string, def float64) float64 {
+	value, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
 // Length counts the number of Unicode characters (runes) in a string.
 //
 // Parameters:
@@ -44,6 +130,445 @@ func Length(str string) int {
 	return utf8.RuneCountInString(str)
 }
 
+// GraphemeLength counts the user-perceived characters (grapheme clusters) in a string.
+// Unlike Length, a letter with combining marks, an emoji with skin tone or a ZWJ family
+// sequence, and a flag made of two regional indicators each count as one character.
+// Clusters are found with the rules described in Graphemes.
+//
+// Parameters:
+//   - s: The string to count grapheme clusters in
+//
+// Returns:
+//   - int: The number of grapheme clusters in the string
+//
+// Example:
+//
+//	GraphemeLength("café") -> 4 (with "é" written as "e" + U+0301)
+//	GraphemeLength("👨‍👩‍👧") -> 1
+//	GraphemeLength("🇯🇵🇫🇷") -> 2
+func GraphemeLength(s string) int {
+	count := 0
+	for s != "" {
+		s = s[graphemeEnd(s):]
+		count++
+	}
+	return count
+}
+
+// Graphemes splits a string into its user-perceived characters (grapheme clusters).
+// It implements the core of the Unicode extended grapheme cluster rules: CR LF pairs,
+// combining and spacing marks, variation selectors, emoji modifiers and tags, emoji ZWJ
+// sequences, regional indicator pairs and Hangul syllable sequences. Rarer rules, such as
+// prepended concatenation marks and Indic conjuncts, are not applied.
+//
+// Parameters:
+//   - s: The string to split
+//
+// Returns:
+//   - []string: The grapheme clusters of the string, in order
+//
+// Example:
+//
+//	Graphemes("e\u0301a") -> []string{"e\u0301", "a"}
+//	Graphemes("👍🏽!") -> []string{"👍🏽", "!"}
+//	Graphemes("") -> []string{}
+func Graphemes(s string) []string {
+	result := []string{}
+	for s != "" {
+		end := graphemeEnd(s)
+		result = append(result, s[:end])
+		s = s[end:]
+	}
+	return result
+}
+
+// graphemeEnd returns the byte length of the first grapheme cluster in a non-empty string.
+func graphemeEnd(s string) int {
+	prev, end := utf8.DecodeRuneInString(s)
+	regionalIndicators := 0
+	if isRegionalIndicator(prev) {
+		regionalIndicators = 1
+	}
+	// pictographic tracks whether the cluster so far is a pictographic rune followed only by
+	// extending runes, the only context in which a ZWJ joins the next pictographic rune
+	pictographic := unicode.Is(extendedPictographic, prev)
+
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+
+		join := false
+		switch {
+		case prev == '\r' && r == '\n':
+			join = true
+		case isGraphemeControl(prev) || isGraphemeControl(r):
+			join = false
+		case isGraphemeExtend(r):
+			join = true
+		case prev == '\u200d' && pictographic:
+			join = unicode.Is(extendedPictographic, r)
+		case isRegionalIndicator(prev) && isRegionalIndicator(r):
+			join = regionalIndicators%2 == 1
+			regionalIndicators++
+		default:
+			join = joinsHangul(prev, r)
+		}
+
+		if !join {
+			break
+		}
+		if !isGraphemeExtend(r) {
+			pictographic = unicode.Is(extendedPictographic, r)
+		}
+		prev = r
+		end += size
+	}
+
+	return end
+}
+
+// isGraphemeControl reports whether r is a control character, which always forms its own cluster.
+func isGraphemeControl(r rune) bool {
+	return unicode.IsControl(r) || r == '\u2028' || r == '\u2029'
+}
+
+// isGraphemeExtend reports whether r attaches to the preceding character: combining and
+// spacing marks (which include variation selectors), ZWJ, emoji modifiers and tag characters.
+func isGraphemeExtend(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc) ||
+		r == '\u200d' ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) ||
+		(r >= 0xE0020 && r <= 0xE007F)
+}
+
+// isRegionalIndicator reports whether r is one of the letters used in pairs to form flags.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// hangulType classifies a rune as a Hangul leading consonant (L), vowel (V), trailing
+// consonant (T), or precomposed LV or LVT syllable. Other runes return "".
+func hangulType(r rune) string {
+	switch {
+	case (r >= 0x1100 && r <= 0x115F) || (r >= 0xA960 && r <= 0xA97C):
+		return "L"
+	case (r >= 0x1160 && r <= 0x11A7) || (r >= 0xD7B0 && r <= 0xD7C6):
+		return "V"
+	case (r >= 0x11A8 && r <= 0x11FF) || (r >= 0xD7CB && r <= 0xD7FB):
+		return "T"
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return "LV"
+		}
+		return "LVT"
+	}
+	return ""
+}
+
+// joinsHangul reports whether two adjacent Hangul runes belong to the same syllable.
+func joinsHangul(prev, r rune) bool {
+	next := hangulType(r)
+	switch hangulType(prev) {
+	case "L":
+		return next != ""
+	case "V", "LV":
+		return next == "V" || next == "T"
+	case "T", "LVT":
+		return next == "T"
+	}
+	return false
+}
+
+// eastAsianWide holds the runes that occupy two terminal columns: the East_Asian_Width
+// Wide (W) and Fullwidth (F) ranges of Unicode 15, which include the emoji that default
+// to emoji presentation. Reserved code points inside the CJK and emoji blocks are treated
+// as wide as well.
+var eastAsianWide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115F, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2329, Hi: 0x232A, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F0, Stride: 1},
+		{Lo: 0x23F3, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x267F, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26CE, Stride: 1},
+		{Lo: 0x26D4, Hi: 0x26D4, Stride: 1},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26F5, Stride: 1},
+		{Lo: 0x26FA, Hi: 0x26FA, Stride: 1},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274C, Stride: 1},
+		{Lo: 0x274E, Hi: 0x274E, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27B0, Stride: 1},
+		{Lo: 0x27BF, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x2E80, Hi: 0x303E, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33FF, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+		{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1},
+		{Lo: 0xA000, Hi: 0xA4CF, Stride: 1},
+		{Lo: 0xA960, Hi: 0xA97C, Stride: 1},
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1},
+		{Lo: 0xFE10, Hi: 0xFE19, Stride: 1},
+		{Lo: 0xFE30, Hi: 0xFE6B, Stride: 1},
+		{Lo: 0xFF00, Hi: 0xFF60, Stride: 1},
+		{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x16FE0, Hi: 0x16FE4, Stride: 1},
+		{Lo: 0x17000, Hi: 0x18CFF, Stride: 1},
+		{Lo: 0x1B000, Hi: 0x1B2FF, Stride: 1},
+		{Lo: 0x1F004, Hi: 0x1F004, Stride: 1},
+		{Lo: 0x1F0CF, Hi: 0x1F0CF, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F202, Stride: 1},
+		{Lo: 0x1F210, Hi: 0x1F23B, Stride: 1},
+		{Lo: 0x1F240, Hi: 0x1F248, Stride: 1},
+		{Lo: 0x1F250, Hi: 0x1F251, Stride: 1},
+		{Lo: 0x1F260, Hi: 0x1F265, Stride: 1},
+		{Lo: 0x1F300, Hi: 0x1F320, Stride: 1},
+		{Lo: 0x1F32D, Hi: 0x1F335, Stride: 1},
+		{Lo: 0x1F337, Hi: 0x1F37C, Stride: 1},
+		{Lo: 0x1F37E, Hi: 0x1F393, Stride: 1},
+		{Lo: 0x1F3A0, Hi: 0x1F3CA, Stride: 1},
+		{Lo: 0x1F3CF, Hi: 0x1F3D3, Stride: 1},
+		{Lo: 0x1F3E0, Hi: 0x1F3F0, Stride: 1},
+		{Lo: 0x1F3F4, Hi: 0x1F3F4, Stride: 1},
+		{Lo: 0x1F3F8, Hi: 0x1F43E, Stride: 1},
+		{Lo: 0x1F440, Hi: 0x1F440, Stride: 1},
+		{Lo: 0x1F442, Hi: 0x1F4FC, Stride: 1},
+		{Lo: 0x1F4FF, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F54B, Hi: 0x1F54E, Stride: 1},
+		{Lo: 0x1F550, Hi: 0x1F567, Stride: 1},
+		{Lo: 0x1F57A, Hi: 0x1F57A, Stride: 1},
+		{Lo: 0x1F595, Hi: 0x1F596, Stride: 1},
+		{Lo: 0x1F5A4, Hi: 0x1F5A4, Stride: 1},
+		{Lo: 0x1F5FB, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6C5, Stride: 1},
+		{Lo: 0x1F6CC, Hi: 0x1F6CC, Stride: 1},
+		{Lo: 0x1F6D0, Hi: 0x1F6D2, Stride: 1},
+		{Lo: 0x1F6D5, Hi: 0x1F6D7, Stride: 1},
+		{Lo: 0x1F6DC, Hi: 0x1F6DF, Stride: 1},
+		{Lo: 0x1F6EB, Hi: 0x1F6EC, Stride: 1},
+		{Lo: 0x1F6F4, Hi: 0x1F6FC, Stride: 1},
+		{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+		{Lo: 0x1F7F0, Hi: 0x1F7F0, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1F9FF, Stride: 1},
+		{Lo: 0x1FA70, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2FFFD, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3FFFD, Stride: 1},
+	},
+}
+
+// DisplayWidth returns the number of terminal columns needed to display a string.
+// East Asian Wide and Fullwidth characters (such as CJK ideographs and most emoji) count
+// as 2 columns, combining marks and other zero-width characters count as 0, and everything
+// else counts as 1. Use it instead of Length when aligning text in a terminal.
+//
+// Parameters:
+//   - s: The string to measure
+//
+// Returns:
+//   - int: The display width of the string in columns
+//
+// Example:
+//
+//	DisplayWidth("abc") -> 3
+//	DisplayWidth("你好") -> 4
+//	DisplayWidth("Go言語") -> 6
+//	DisplayWidth("e\u0301") -> 1
+func DisplayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc):
+			// Combining marks, format characters such as ZWJ, and controls take no space
+		case unicode.Is(eastAsianWide, r):
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+// extendedPictographic holds the runes with the Unicode 15 Extended_Pictographic property
+// from U+2300 on. The few pictographic symbols below U+2300, such as ©, ®, ™ and the
+// arrows, are left out because they are mostly used as ordinary text.
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x2388, Hi: 0x2388, Stride: 1},
+		{Lo: 0x23CF, Hi: 0x23CF, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x23F8, Hi: 0x23FA, Stride: 1},
+		{Lo: 0x24C2, Hi: 0x24C2, Stride: 1},
+		{Lo: 0x25AA, Hi: 0x25AB, Stride: 1},
+		{Lo: 0x25B6, Hi: 0x25B6, Stride: 1},
+		{Lo: 0x25C0, Hi: 0x25C0, Stride: 1},
+		{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2605, Stride: 1},
+		{Lo: 0x2607, Hi: 0x2612, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2685, Stride: 1},
+		{Lo: 0x2690, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271D, Hi: 0x271D, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274C, Stride: 1},
+		{Lo: 0x274E, Hi: 0x274E, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2767, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27A1, Hi: 0x27A1, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27B0, Stride: 1},
+		{Lo: 0x27BF, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303D, Hi: 0x303D, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F000, Hi: 0x1F0FF, Stride: 1},
+		{Lo: 0x1F10D, Hi: 0x1F10F, Stride: 1},
+		{Lo: 0x1F12F, Hi: 0x1F12F, Stride: 1},
+		{Lo: 0x1F16C, Hi: 0x1F171, Stride: 1},
+		{Lo: 0x1F17E, Hi: 0x1F17F, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F1AD, Hi: 0x1F1E5, Stride: 1},
+		{Lo: 0x1F201, Hi: 0x1F20F, Stride: 1},
+		{Lo: 0x1F21A, Hi: 0x1F21A, Stride: 1},
+		{Lo: 0x1F22F, Hi: 0x1F22F, Stride: 1},
+		{Lo: 0x1F232, Hi: 0x1F23A, Stride: 1},
+		{Lo: 0x1F23C, Hi: 0x1F23F, Stride: 1},
+		{Lo: 0x1F249, Hi: 0x1F3FA, Stride: 1},
+		{Lo: 0x1F400, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F546, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6FF, Stride: 1},
+		{Lo: 0x1F774, Hi: 0x1F77F, Stride: 1},
+		{Lo: 0x1F7D5, Hi: 0x1F7FF, Stride: 1},
+		{Lo: 0x1F80C, Hi: 0x1F80F, Stride: 1},
+		{Lo: 0x1F848, Hi: 0x1F84F, Stride: 1},
+		{Lo: 0x1F85A, Hi: 0x1F85F, Stride: 1},
+		{Lo: 0x1F888, Hi: 0x1F88F, Stride: 1},
+		{Lo: 0x1F8AE, Hi: 0x1F8FF, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x1FC00, Hi: 0x1FFFD, Stride: 1},
+	},
+}
+
+// isEmojiRune reports whether r is an emoji on its own or a part that only appears in
+// emoji: a pictographic rune, a regional indicator or a skin tone modifier.
+func isEmojiRune(r rune) bool {
+	return unicode.Is(extendedPictographic, r) || isRegionalIndicator(r) || (r >= 0x1F3FB && r <= 0x1F3FF)
+}
+
+// isEmojiCluster reports whether a grapheme cluster is an emoji: it starts with an emoji
+// rune, or it is a keycap sequence such as "1️⃣".
+func isEmojiCluster(cluster string) bool {
+	first, _ := utf8.DecodeRuneInString(cluster)
+	return isEmojiRune(first) || strings.ContainsRune(cluster, '\u20e3')
+}
+
+// RemoveEmojis removes emoji and pictographic symbols from a string, as identified by the
+// Unicode Extended_Pictographic property. Dingbats that are not pictographic, such as ✓ and ➜, are kept.
+// The string is processed one grapheme cluster at a time, so multi-rune emoji such as ZWJ
+// sequences, skin tone variants, flags and keycaps are removed whole instead of leaving
+// stray joiners or modifiers behind. The surrounding text, including spaces, is kept as is.
+//
+// Parameters:
+//   - s: The string to clean
+//
+// Returns:
+//   - string: The string without emoji
+//
+// Example:
+//
+//	RemoveEmojis("Hello 👋 World") -> "Hello  World"
+//	RemoveEmojis("team👨‍👩‍👧") -> "team"
+//	RemoveEmojis("I ❤️ Go 🇯🇵") -> "I  Go "
+//	RemoveEmojis("© 2024") -> "© 2024" (text-style symbols are kept)
+func RemoveEmojis(s string) string {
+	var result strings.Builder
+	result.Grow(len(s))
+
+	for s != "" {
+		end := graphemeEnd(s)
+		cluster := s[:end]
+		s = s[end:]
+
+		if isEmojiCluster(cluster) {
+			continue
+		}
+		for _, r := range cluster {
+			if !isEmojiRune(r) {
+				result.WriteRune(r)
+			}
+		}
+	}
+
+	return result.String()
+}
+
+// ContainsEmoji checks if a string contains any emoji or pictographic symbol.
+// It recognizes the same characters that RemoveEmojis removes.
+//
+// Parameters:
+//   - s: The string to check
+//
+// Returns:
+//   - bool: True if the string contains an emoji, false otherwise
+//
+// Example:
+//
+//	ContainsEmoji("Hello 👋") -> true
+//	ContainsEmoji("#️⃣") -> true
+//	ContainsEmoji("Hello") -> false
+func ContainsEmoji(s string) bool {
+	for _, r := range s {
+		if isEmojiRune(r) || r == '\u20e3' {
+			return true
+		}
+	}
+	return false
+}
+
 // Words splits string into an array of its words.
 // It handles various word boundaries including camelCase, snake_case, and kebab-case.
 //
@@ -70,15 +595,11 @@ func Words(str string) []string {
 		return []string{}
 	}
 
-	// Enhanced regular expression to handle number-letter boundaries
-	// This handles:
+	// Find all matches of the word regular expression, which handles:
 	// - Sequences of letters followed by numbers (Int8 -> Int, 8)
 	// - Numbers followed by letters (8Value -> 8, Value)
 	// - CamelCase transitions
 	// - Underscores, hyphens, and other separators
-	wordRegex := regexp.MustCompile(`[A-Z]*[a-z]+|[A-Z]+[a-z]*|\d+|[a-z]+`)
-
-	// Find all matches
 	matches := wordRegex.FindAllString(str, -1)
 
 	var words []string
@@ -135,6 +656,67 @@ func WordsPattern(s, pattern string) []string {
 	return words
 }
 
+// SplitCamelCase splits a string into words on camelCase, PascalCase and letter/number
+// boundaries, as well as on whitespace and punctuation. Unlike Words, the original
+// casing of each word is kept, so callers can re-case the words as they like.
+//
+// Parameters:
+//   - str: The string to split into words
+//
+// Returns:
+//   - []string: The words extracted from the string, in their original case
+//
+// Example:
+//
+//	SplitCamelCase("camelCaseText123") -> ["camel", "Case", "Text", "123"]
+//	SplitCamelCase("XMLHttpRequest") -> ["XML", "Http", "Request"]
+//	SplitCamelCase("user_ID") -> ["user", "ID"]
+//	SplitCamelCase("") -> []
+func SplitCamelCase(str string) []string {
+	if str == "" {
+		return []string{}
+	}
+
+	var words []string
+	var currentWord strings.Builder
+	runes := []rune(str)
+
+	// addWord appends the current word if it is valid and starts a new one
+	addWord := func() {
+		if currentWord.Len() > 0 {
+			word := currentWord.String()
+			if isValidWord(word) {
+				words = append(words, word)
+			}
+			currentWord.Reset()
+		}
+	}
+
+	for i, r := range runes {
+		// Skip whitespace and punctuation separators
+		if unicode.IsSpace(r) || isPunctuation(r) {
+			addWord()
+			continue
+		}
+
+		// Boundary conditions:
+		// 1. Letter to number (Int8 -> Int|8)
+		// 2. Number to letter (8Value -> 8|Value)
+		// 3. Lowercase to uppercase (camelCase -> camel|Case)
+		// 4. Multiple uppercase to lowercase (XMLHttp -> XML|Http)
+		if i > 0 && shouldSplit(runes[i-1], r, i, runes) {
+			addWord()
+		}
+
+		currentWord.WriteRune(r)
+	}
+
+	// Add the last word
+	addWord()
+
+	return words
+}
+
 // CamelCase converts a string to camelCase format.
 // It splits the string into words, converts the first word to lowercase,
 // and capitalizes the first letter of each subsequent word with no separators.
@@ -186,12 +768,10 @@ func KebabCase(s string) string {
 	s = changeSeparator(s, "-")
 
 	// Remove special characters
-	reg := regexp.MustCompile("[^a-z0-9-]")
-	s = reg.ReplaceAllString(s, "")
+	s = nonKebabRegex.ReplaceAllString(s, "")
 
 	// Replace multiple hyphens with a single hyphen
-	reg = regexp.MustCompile("-+")
-	s = reg.ReplaceAllString(s, "-")
+	s = multiHyphenRegex.ReplaceAllString(s, "-")
 
 	// Trim hyphens from start and end
 	s = strings.Trim(s, "-")
@@ -218,12 +798,10 @@ func SnakeCase(s string) string {
 	s = changeSeparator(s, "_")
 
 	// Remove special characters
-	reg := regexp.MustCompile("[^a-z0-9_]")
-	s = reg.ReplaceAllString(s, "")
+	s = nonSnakeRegex.ReplaceAllString(s, "")
 
 	// Replace multiple underscores with a single underscore
-	reg = regexp.MustCompile("_+")
-	s = reg.ReplaceAllString(s, "_")
+	s = multiUnderscoreRegex.ReplaceAllString(s, "_")
 
 	// Trim underscores from start and end
 	s = strings.Trim(s, "_")
@@ -231,6 +809,183 @@ func SnakeCase(s string) string {
 	return s
 }
 
+// SnakeToCamel converts a snake_case string to camelCase in a single pass.
+// It is a fast alternative to CamelCase for input that is already snake_case:
+// underscores are removed, the first letter of every word except the first is
+// uppercased and all other letters are lowercased.
+//
+// Parameters:
+//   - s: The snake_case string to convert
+//
+// Returns:
+//   - string: The camelCase formatted string
+//
+// Example:
+//
+//	SnakeToCamel("user_id") -> "userId"
+//	SnakeToCamel("created_at_utc") -> "createdAtUtc"
+//	SnakeToCamel("__private_field") -> "privateField"
+func SnakeToCamel(s string) string {
+	var result strings.Builder
+	result.Grow(len(s))
+
+	upperNext := false
+	for _, r := range s {
+		if r == '_' {
+			upperNext = result.Len() > 0
+			continue
+		}
+		if upperNext {
+			result.WriteRune(unicode.ToUpper(r))
+			upperNext = false
+		} else {
+			result.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return result.String()
+}
+
+// CamelToSnake converts a camelCase or PascalCase string to snake_case in a single pass.
+// It is a fast alternative to SnakeCase for input that is already camelCase, splitting on
+// the same boundaries as SplitCamelCase (e.g. "XMLHttp" -> "xml_http", "Int8" -> "int_8").
+//
+// Parameters:
+//   - s: The camelCase string to convert
+//
+// Returns:
+//   - string: The snake_case formatted string
+//
+// Example:
+//
+//	CamelToSnake("userId") -> "user_id"
+//	CamelToSnake("XMLHttpRequest") -> "xml_http_request"
+//	CamelToSnake("CreatedAt") -> "created_at"
+func CamelToSnake(s string) string {
+	var result strings.Builder
+	result.Grow(len(s) + len(s)/4)
+
+	runes := []rune(s)
+	for i, r := range runes {
+		if i > 0 && runes[i-1] != '_' && r != '_' && shouldSplit(runes[i-1], r, i, runes) {
+			result.WriteByte('_')
+		}
+		result.WriteRune(unicode.ToLower(r))
+	}
+
+	return result.String()
+}
+
+// SnakeCaseAcronyms converts a string to snake_case like SnakeCase, but treats each of the
+// given acronyms as a single atomic word. Acronyms are matched case-sensitively and the
+// longest acronym wins when several match at the same position. An acronym only matches
+// as a whole word: it must start and end at the edge of the string, a separator, a
+// lowercase to uppercase transition, or another acronym, so "API" is not found in "CAPITAL".
+//
+// Parameters:
+//   - s: The string to convert to snake_case
+//   - acronyms: The acronyms to keep together, e.g. "API", "OAuth"
+//
+// Returns:
+//   - string: The snake_case formatted string
+//
+// Example:
+//
+//	SnakeCase("getAPIKey") -> "get_apikey"
+//	SnakeCaseAcronyms("getAPIKey", []string{"API"}) -> "get_api_key"
+//	SnakeCaseAcronyms("getHTTPSURL", []string{"HTTPS", "URL"}) -> "get_https_url"
+//	SnakeCaseAcronyms("CAPITAL", []string{"API"}) -> "capital"
+func SnakeCaseAcronyms(s string, acronyms []string) string {
+	return strings.Join(acronymWords(s, acronyms), "_")
+}
+
+// KebabCaseAcronyms converts a string to kebab-case like KebabCase, but treats each of the
+// given acronyms as a single atomic word. See SnakeCaseAcronyms for the matching rules.
+//
+// Parameters:
+//   - s: The string to convert to kebab-case
+//   - acronyms: The acronyms to keep together, e.g. "API", "OAuth"
+//
+// Returns:
+//   - string: The kebab-case formatted string
+//
+// Example:
+//
+//	KebabCaseAcronyms("getAPIKey", []string{"API"}) -> "get-api-key"
+func KebabCaseAcronyms(s string, acronyms []string) string {
+	return strings.Join(acronymWords(s, acronyms), "-")
+}
+
+// acronymWords splits s into lowercase words, keeping any of the given acronyms intact.
+// The text between acronyms is split with Words.
+func acronymWords(s string, acronyms []string) []string {
+	sorted := make([]string, 0, len(acronyms))
+	for _, acronym := range acronyms {
+		if acronym != "" {
+			sorted = append(sorted, acronym)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	// matchAt returns the longest acronym at s[i:] that starts and ends on a word boundary
+	var matchAt func(i, start int) string
+	matchAt = func(i, start int) string {
+		for _, acronym := range sorted {
+			if !strings.HasPrefix(s[i:], acronym) {
+				continue
+			}
+
+			first, _ := utf8.DecodeRuneInString(acronym)
+			if i != start {
+				if prev, _ := utf8.DecodeLastRuneInString(s[:i]); !isWordBoundary(prev, first) {
+					continue
+				}
+			}
+
+			end := i + len(acronym)
+			if end == len(s) {
+				return acronym
+			}
+			last, _ := utf8.DecodeLastRuneInString(acronym)
+			next, size := utf8.DecodeRuneInString(s[end:])
+			afterNext, _ := utf8.DecodeRuneInString(s[end+size:])
+			if isWordBoundary(last, next) ||
+				(unicode.IsUpper(next) && unicode.IsLower(afterNext)) ||
+				matchAt(end, end) != "" {
+				return acronym
+			}
+		}
+		return ""
+	}
+
+	words := []string{}
+	start := 0
+	for i := 0; i < len(s); {
+		matched := matchAt(i, start)
+		if matched == "" {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+			continue
+		}
+
+		words = append(words, Words(s[start:i])...)
+		words = append(words, strings.ToLower(matched))
+		i += len(matched)
+		start = i
+	}
+
+	return append(words, Words(s[start:])...)
+}
+
+// isWordBoundary reports whether a word boundary falls between two adjacent runes: around
+// separators, between letters and digits, and at a lowercase to uppercase transition.
+func isWordBoundary(prev, next rune) bool {
+	if !unicode.IsLetter(prev) && !unicode.IsDigit(prev) || !unicode.IsLetter(next) && !unicode.IsDigit(next) {
+		return true
+	}
+	return unicode.IsDigit(prev) != unicode.IsDigit(next) || (unicode.IsLower(prev) && unicode.IsUpper(next))
+}
+
 // PascalCase converts string to PascalCase format (also known as UpperCamelCase).
 // It splits the string into words, capitalizes the first letter of each word,
 // and joins them without separators.
@@ -277,6 +1032,83 @@ func Headline(s string) string {
 	return strings.Join(items, " ")
 }
 
+// Acronym builds an initialism from the uppercase first letter of each word.
+// Words are detected with Words, so camelCase, snake_case and kebab-case input also work.
+// Words made of digits are skipped unless includeDigits is set.
+//
+// Parameters:
+//   - s: The string to build the acronym from
+//   - includeDigits: Optional flag to keep the first digit of numeric words (default: false)
+//
+// Returns:
+//   - string: The acronym
+//
+// Example:
+//
+//	Acronym("Portable Document Format") -> "PDF"
+//	Acronym("as soon as possible") -> "ASAP"
+//	Acronym("Web 3 Foundation") -> "WF"
+//	Acronym("Web 3 Foundation", true) -> "W3F"
+//	Acronym("") -> ""
+func Acronym(s string, includeDigits ...bool) string {
+	withDigits := len(includeDigits) > 0 && includeDigits[0]
+
+	var result strings.Builder
+	for _, word := range Words(s) {
+		first, _ := utf8.DecodeRuneInString(word)
+		if unicode.IsDigit(first) && !withDigits {
+			continue
+		}
+		result.WriteRune(unicode.ToUpper(first))
+	}
+
+	return result.String()
+}
+
+// Initials returns up to max uppercase initials from a person's name.
+// When the name has more parts than max, the last name is kept and middle names are dropped.
+// Extra whitespace is ignored.
+//
+// Parameters:
+//   - name: The person's name
+//   - max: The maximum number of initials to return
+//
+// Returns:
+//   - string: The initials, or an empty string if max is not positive
+//
+// Example:
+//
+//	Initials("John Q. Public", 2) -> "JP"
+//	Initials("John Q. Public", 3) -> "JQP"
+//	Initials("  madonna  ", 2) -> "M"
+//	Initials("Mary Jane Watson", 1) -> "M"
+func Initials(name string, max int) string {
+	if max <= 0 {
+		return ""
+	}
+
+	initials := make([]rune, 0)
+	for _, part := range strings.Fields(name) {
+		for _, r := range part {
+			if unicode.IsLetter(r) {
+				initials = append(initials, unicode.ToUpper(r))
+				break
+			}
+		}
+	}
+
+	if len(initials) > max {
+		if max == 1 {
+			initials = initials[:1]
+		} else {
+			// Keep the first initials and the last name's initial
+			initials = append(initials[:max-1], initials[len(initials)-1])
+		}
+	}
+
+	return string(initials)
+}
+
 // Capitalize capitalizes the first character of a string.
 // It leaves the rest of the string unchanged. If the string is empty, it returns an empty string.
 //
@@ -355,26 +1187,161 @@ func StartsWith(s string, substrings ...string) bool {
 	return false
 }
 
+// StartsWithFold checks if a string starts with any of the given substrings, ignoring case.
+// Case is compared with Unicode simple case folding, as in strings.EqualFold, so no
+// lowercased copies of the operands are allocated.
+//
+// Parameters:
+//   - s: The string to check
+//   - substrings: One or more substrings to check for at the beginning of the string
+//
+// Returns:
+//   - bool: True if the string starts with any of the given substrings, false otherwise
+//
+// Example:
+//
+//	StartsWithFold("HÉllo", "hé") -> true
+//	StartsWithFold("abc", "X", "AB") -> true
+//	StartsWithFold("abc", "d") -> false
+func StartsWithFold(s string, substrings ...string) bool {
+	for _, substr := range substrings {
+		if hasPrefixFold(s, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// EndsWithFold checks if a string ends with any of the given substrings, ignoring case.
+// Case is compared with Unicode simple case folding, as in strings.EqualFold.
+//
+// Parameters:
+//   - s: The string to check
+//   - substrings: One or more substrings to check for at the end of the string
+//
+// Returns:
+//   - bool: True if the string ends with any of the given substrings, false otherwise
+//
+// Example:
+//
+//	EndsWithFold("photo.JPG", ".jpg") -> true
+//	EndsWithFold("abc", "X", "BC") -> true
+//	EndsWithFold("abc", "d") -> false
+func EndsWithFold(s string, substrings ...string) bool {
+	for _, substr := range substrings {
+		if hasSuffixFold(s, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefixFold reports whether s begins with prefix under simple case folding.
+// Folding maps runes one to one, so the prefix is compared against as many runes of s.
+func hasPrefixFold(s, prefix string) bool {
+	end := 0
+	for range prefix {
+		if end >= len(s) {
+			return false
+		}
+		_, size := utf8.DecodeRuneInString(s[end:])
+		end += size
+	}
+	return strings.EqualFold(s[:end], prefix)
+}
+
+// hasSuffixFold reports whether s ends with suffix under simple case folding.
+func hasSuffixFold(s, suffix string) bool {
+	start := len(s)
+	for range suffix {
+		if start <= 0 {
+			return false
+		}
+		_, size := utf8.DecodeLastRuneInString(s[:start])
+		start -= size
+	}
+	return strings.EqualFold(s[start:], suffix)
+}
+
 // Trim removes leading and trailing whitespace or specified characters from a string.
 //
 // Parameters:
-//   - s: The string to trim
-//   - cutset: Optional string containing the characters to trim. If not provided, whitespace is trimmed.
+//   - s: The string to trim
+//   - cutset: Optional string containing the characters to trim. If not provided, whitespace is trimmed.
+//
+// Returns:
+//   - string: The trimmed string
+//
+// Example:
+//
+//	Trim("  abc  ") -> "abc"
+//	Trim("-_-abc-_-", "-_") -> "abc"
+//	Trim("abc") -> "abc"
+//	Trim("") -> ""
+func Trim(s string, cutset ...string) string {
+	if len(cutset) > 0 {
+		return strings.Trim(s, cutset[0])
+	}
+	return strings.TrimSpace(s)
+}
+
+// TrimAny removes leading and trailing characters found in any of the given cutsets.
+// The cutsets are combined, so TrimAny(s, "-", "_") is the same as Trim(s, "-_").
+// If no cutsets are provided, whitespace is trimmed.
+//
+// Parameters:
+//   - s: The string to trim
+//   - cutsets: Strings containing the characters to trim
+//
+// Returns:
+//   - string: The trimmed string
+//
+// Example:
+//
+//	TrimAny("-_-abc-_-", "-", "_") -> "abc"
+//	TrimAny("[(abc)]", "[]", "()") -> "abc"
+//	TrimAny("  abc  ") -> "abc"
+func TrimAny(s string, cutsets ...string) string {
+	if len(cutsets) == 0 {
+		return strings.TrimSpace(s)
+	}
+	return strings.Trim(s, strings.Join(cutsets, ""))
+}
+
+// TrimPrefixes removes the first of the given prefixes that the string starts with.
+// At most one prefix is removed; see TrimPrefixRepeat to strip a repeated prefix.
+//
+// Parameters:
+//   - s: The string to process
+//   - prefixes: The candidate prefixes, checked in order
+//
+// Returns:
+//   - string: The string with the first matching prefix removed
+//
+// Example:
+//
+//	TrimPrefixes("https://example.com", "http://", "https://") -> "example.com"
+//	TrimPrefixes("example.com", "http://", "https://") -> "example.com"
+func TrimPrefixes(s string, prefixes ...string) string {
+	return ChopStart(s, prefixes)
+}
+
+// TrimSuffixes removes the first of the given suffixes that the string ends with.
+// At most one suffix is removed; see TrimSuffixRepeat to strip a repeated suffix.
+//
+// Parameters:
+//   - s: The string to process
+//   - suffixes: The candidate suffixes, checked in order
 //
 // Returns:
-//   - string: The trimmed string
+//   - string: The string with the first matching suffix removed
 //
 // Example:
 //
-//	Trim("  abc  ") -> "abc"
-//	Trim("-_-abc-_-", "-_") -> "abc"
-//	Trim("abc") -> "abc"
-//	Trim("") -> ""
-func Trim(s string, cutset ...string) string {
-	if len(cutset) > 0 {
-		return strings.Trim(s, cutset[0])
-	}
-	return strings.TrimSpace(s)
+//	TrimSuffixes("archive.tar.gz", ".gz", ".tar.gz") -> "archive.tar"
+//	TrimSuffixes("archive.zip", ".gz", ".tar.gz") -> "archive.zip"
+func TrimSuffixes(s string, suffixes ...string) string {
+	return ChopEnd(s, suffixes)
 }
 
 // TrimStart removes leading whitespace or specified characters from a string.
@@ -473,6 +1440,71 @@ func Split(s, separator string) []string {
 	return strings.Split(s, separator)
 }
 
+// SplitAfter splits a string after each occurrence of the separator, keeping the
+// separator at the end of each piece. It wraps strings.SplitAfter.
+//
+// Parameters:
+//   - s: The string to split
+//   - separator: The separator to split after
+//
+// Returns:
+//   - []string: An array of substrings, each ending with the separator except possibly the last
+//
+// Example:
+//
+//	SplitAfter("a,b,c", ",") -> ["a,", "b,", "c"]
+//	SplitAfter("a,b,", ",") -> ["a,", "b,", ""]
+//	SplitAfter("abc", ",") -> ["abc"]
+func SplitAfter(s, separator string) []string {
+	return strings.SplitAfter(s, separator)
+}
+
+// SplitKeep splits a string around the matches of a regular expression and keeps each
+// matched delimiter as a separate token, which makes it useful for simple tokenizers.
+// Empty text between adjacent delimiters, and empty matches, are omitted. If the pattern
+// is invalid, the whole string is returned as a single token.
+//
+// Parameters:
+//   - s: The string to split
+//   - pattern: The regular expression matching the delimiters
+//
+// Returns:
+//   - []string: The text pieces and delimiters, in order
+//
+// Example:
+//
+//	SplitKeep("1+2*3", `[+*]`) -> ["1", "+", "2", "*", "3"]
+//	SplitKeep("a, b;c", `[,;]\s*`) -> ["a", ", ", "b", ";", "c"]
+//	SplitKeep("(a)", `[()]`) -> ["(", "a", ")"]
+func SplitKeep(s, pattern string) []string {
+	if s == "" {
+		return []string{}
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return []string{s}
+	}
+
+	var tokens []string
+	last := 0
+	for _, match := range re.FindAllStringIndex(s, -1) {
+		if match[0] == match[1] {
+			continue
+		}
+		if match[0] > last {
+			tokens = append(tokens, s[last:match[0]])
+		}
+		tokens = append(tokens, s[match[0]:match[1]])
+		last = match[1]
+	}
+	if last < len(s) {
+		tokens = append(tokens, s[last:])
+	}
+
+	return tokens
+}
+
 // Join joins an array of strings with the given separator.
 //
 // Parameters:
@@ -509,6 +1541,66 @@ func Repeat(s string, n int) string {
 	return strings.Repeat(s, n)
 }
 
+// RepeatJoin repeats a string n times, placing a separator between each copy.
+//
+// Parameters:
+//   - s: The string to repeat
+//   - n: The number of times to repeat the string
+//   - sep: The separator to insert between copies
+//
+// Returns:
+//   - string: The repeated string, or an empty string if n is not positive
+//
+// Example:
+//
+//	RepeatJoin("ab", 3, "-") -> "ab-ab-ab"
+//	RepeatJoin("?", 3, ",") -> "?,?,?"
+//	RepeatJoin("ab", 1, "-") -> "ab"
+//	RepeatJoin("ab", 0, "-") -> ""
+func RepeatJoin(s string, n int, sep string) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return strings.Repeat(s+sep, n-1) + s
+}
+
+// PlaceholderList builds a comma-separated list of n SQL placeholders, e.g. for IN clauses.
+// The "$" style produces numbered Postgres-style placeholders; any other style is repeated as-is.
+//
+// Parameters:
+//   - n: The number of placeholders
+//   - style: The placeholder style, such as "?" or "$"
+//
+// Returns:
+//   - string: The placeholder list, or an empty string if n is not positive
+//
+// Example:
+//
+//	PlaceholderList(3, "?") -> "?,?,?"
+//	PlaceholderList(3, "$") -> "$1,$2,$3"
+//	PlaceholderList(0, "?") -> ""
+func PlaceholderList(n int, style string) string {
+	if n <= 0 {
+		return ""
+	}
+
+	if style != "$" {
+		return RepeatJoin(style, n, ",")
+	}
+
+	var result strings.Builder
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			result.WriteByte(',')
+		}
+		result.WriteByte('$')
+		result.WriteString(strconv.Itoa(i))
+	}
+
+	return result.String()
+}
+
 // Replace replaces all occurrences of a given value in a string with another value.
 //
 // Parameters:
@@ -666,6 +1758,35 @@ func Contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
 
+// ContainsFold determines if a string contains a given substring, ignoring case.
+// Case is compared with Unicode simple case folding, as in strings.EqualFold, so no
+// lowercased copies of the operands are allocated.
+//
+// Parameters:
+//   - s: The string to search in
+//   - substr: The substring to search for
+//
+// Returns:
+//   - bool: True if substring is found, false otherwise
+//
+// Example:
+//
+//	ContainsFold("Hello World", "WORLD") -> true
+//	ContainsFold("Straße", "STRASSE") -> false (ß does not fold to "ss")
+//	ContainsFold("abc", "") -> true
+func ContainsFold(s, substr string) bool {
+	if substr == "" {
+		return true
+	}
+
+	for i := range s {
+		if hasPrefixFold(s[i:], substr) {
+			return true
+		}
+	}
+	return false
+}
+
 // Count counts the occurrences of a substring in a string.
 //
 // Parameters:
@@ -800,6 +1921,43 @@ func Truncate(s string, maxLength int) string {
 	return s[:maxLength] + "..."
 }
 
+// TruncateWith truncates a string to at most length characters (runes), including the
+// omission string, which replaces the removed text. Unlike Truncate, the length is counted
+// in runes, so multi-byte characters are never split. If the omission alone is longer than
+// length, it is cut to fit.
+//
+// Parameters:
+//   - s: The input string to truncate
+//   - length: The maximum number of runes in the result, omission included
+//   - omission: The text that marks the truncation, e.g. "..." or "…"
+//
+// Returns:
+//   - string: The original string if it fits, otherwise the truncated string ending in omission
+//
+// Example:
+//
+//	TruncateWith("héllo wörld", 5, "…") -> "héll…"
+//	TruncateWith("Hello, World", 8, "...") -> "Hello..."
+//	TruncateWith("Hello", 10, "...") -> "Hello"
+//	TruncateWith("Hello", 0, "...") -> ""
+func TruncateWith(s string, length int, omission string) string {
+	if length <= 0 {
+		return ""
+	}
+
+	runes := []rune(s)
+	if len(runes) <= length {
+		return s
+	}
+
+	omissionRunes := []rune(omission)
+	if len(omissionRunes) >= length {
+		return string(omissionRunes[:length])
+	}
+
+	return string(runes[:length-len(omissionRunes)]) + omission
+}
+
 // Slugify converts a string to a URL-friendly slug.
 // It performs the following transformations:
 //   - Converts to lowercase
@@ -828,12 +1986,10 @@ func Slugify(s string) string {
 	s = strings.ReplaceAll(s, " ", "-")
 
 	// Remove special characters
-	reg := regexp.MustCompile("[^a-z0-9-]")
-	s = reg.ReplaceAllString(s, "")
+	s = nonKebabRegex.ReplaceAllString(s, "")
 
 	// Replace multiple hyphens with a single hyphen
-	reg = regexp.MustCompile("-+")
-	s = reg.ReplaceAllString(s, "-")
+	s = multiHyphenRegex.ReplaceAllString(s, "-")
 
 	// Trim hyphens from start and end
 	s = strings.Trim(s, "-")
@@ -882,84 +2038,404 @@ func ContainsAny(s string, substrings ...string) bool {
 			return true
 		}
 	}
-	return false
+	return false
+}
+
+// ToTitleCase converts a string to title case format where the first letter
+// of each word is capitalized and the rest of the letters in each word are lowercase.
+//
+// Parameters:
+//   - s: The string to convert to title case
+//
+// Returns:
+//   - string: The title cased string
+//
+// Example:
+//
+//	ToTitleCase("hello world") -> "Hello World"
+//	ToTitleCase("HELLO WORLD") -> "Hello World"
+//	ToTitleCase("hello WORLD") -> "Hello World"
+//	ToTitleCase("") -> ""
+func ToTitleCase(s string) string {
+	words := strings.Fields(strings.ToLower(s))
+	for i, word := range words {
+		if word != "" {
+			r := []rune(word)
+			r[0] = unicode.ToUpper(r[0])
+			words[i] = string(r)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// CapitalizeWords capitalizes the first letter of every word, where words are separated
+// by any of the given delimiters. The delimiters and the rest of each word are left unchanged.
+// If no delimiters are given, space, '-', '_' and '/' are used.
+//
+// Parameters:
+//   - s: The string to capitalize
+//   - delimiters: Optional runes that separate words
+//
+// Returns:
+//   - string: The string with the first letter of every word capitalized
+//
+// Example:
+//
+//	CapitalizeWords("mary-jane watson") -> "Mary-Jane Watson"
+//	CapitalizeWords("o'neil", '\'') -> "O'Neil"
+//	CapitalizeWords("path/to_file") -> "Path/To_File"
+//	CapitalizeWords("") -> ""
+func CapitalizeWords(s string, delimiters ...rune) string {
+	if len(delimiters) == 0 {
+		delimiters = []rune{' ', '-', '_', '/'}
+	}
+
+	runes := []rune(s)
+	capitalizeNext := true
+	for i, r := range runes {
+		if strings.ContainsRune(string(delimiters), r) {
+			capitalizeNext = true
+			continue
+		}
+		if capitalizeNext {
+			runes[i] = unicode.ToUpper(r)
+			capitalizeNext = false
+		}
+	}
+
+	return string(runes)
+}
+
+// CapitalizeSentences capitalizes the first letter of each sentence, leaving the rest of
+// the text unchanged. A new sentence starts at the beginning of the string and after '.',
+// '!' or '?' followed by whitespace, optionally with closing quotes or brackets in between.
+// Opening punctuation such as quotes is skipped, while a sentence starting with a digit is
+// left as is. Abbreviations like "e.g. " are not detected.
+//
+// Parameters:
+//   - s: The string to capitalize
+//
+// Returns:
+//   - string: The string with the first letter of each sentence capitalized
+//
+// Example:
+//
+//	CapitalizeSentences("hello there. how are you? fine!") -> "Hello there. How are you? Fine!"
+//	CapitalizeSentences("it costs 3.50. \"really?\" yes.") -> "It costs 3.50. \"Really?\" Yes."
+//	CapitalizeSentences("he said \"wow.\" then left") -> "He said \"wow.\" Then left"
+func CapitalizeSentences(s string) string {
+	runes := []rune(s)
+	capitalizeNext := true
+	for i, r := range runes {
+		switch {
+		case capitalizeNext && unicode.IsLetter(r):
+			runes[i] = unicode.ToUpper(r)
+			capitalizeNext = false
+		case capitalizeNext && unicode.IsDigit(r):
+			capitalizeNext = false
+		case r == '.' || r == '!' || r == '?':
+			// Closing quotes and brackets may sit between the terminator and the whitespace
+			next := i + 1
+			for next < len(runes) && strings.ContainsRune("\"')]’”", runes[next]) {
+				next++
+			}
+			if next < len(runes) && unicode.IsSpace(runes[next]) {
+				capitalizeNext = true
+			}
+		}
+	}
+
+	return string(runes)
+}
+
+// OnlyAlphanumeric removes all non-alphanumeric characters from a string.
+// This includes spaces, punctuation, and special characters.
+//
+// Parameters:
+//   - s: The string to process
+//
+// Returns:
+//   - string: The string with only alphanumeric characters
+//
+// Example:
+//
+//	OnlyAlphanumeric("Hello, World!") -> "HelloWorld"
+//	OnlyAlphanumeric("abc123") -> "abc123"
+//	OnlyAlphanumeric("a b c") -> "abc"
+//	OnlyAlphanumeric("!@#$%^") -> ""
+func OnlyAlphanumeric(s string) string {
+	return nonAlphanumericRegex.ReplaceAllString(s, "")
+}
+
+// Mask masks a portion of a string with the specified character.
+// It leaves a specified number of characters visible at the beginning and end of the string.
+//
+// Parameters:
+//   - s: The string to mask
+//   - startVisible: Number of characters to leave visible at start
+//   - endVisible: Number of characters to leave visible at end
+//   - maskChar: The character to use for masking
+//
+// Returns:
+//   - string: The masked string
+//
+// Example:
+//
+//	Mask("1234567890", 4, 2, '*') -> "1234****90"
+//	Mask("1234567890", 2, 2, '#') -> "12######90"
+//	Mask("1234567890", 0, 4, '*') -> "******7890"
+//	Mask("Crème brûlée", 2, 2, '*') -> "Cr********ée"
+//	Mask("1234", 2, 2, '*') -> "1234" (no masking if string is too short)
+func Mask(s string, startVisible, endVisible int, maskChar rune) string {
+	startVisible = max(startVisible, 0)
+	endVisible = max(endVisible, 0)
+
+	// Count characters, not bytes, so multi-byte characters are never split
+	runes := []rune(s)
+	if len(runes) <= startVisible+endVisible {
+		return s
+	}
+
+	start := string(runes[:startVisible])
+	end := string(runes[len(runes)-endVisible:])
+	masked := strings.Repeat(string(maskChar), len(runes)-startVisible-endVisible)
+
+	return start + masked + end
+}
+
+// MaskCreditCard masks every digit of a card number except the last four.
+// Spaces, dashes and other formatting characters are kept in place.
+//
+// Parameters:
+//   - s: The card number to mask
+//
+// Returns:
+//   - string: The masked card number
+//
+// Example:
+//
+//	MaskCreditCard("4111 1111 1111 1234") -> "**** **** **** 1234"
+//	MaskCreditCard("4111111111111234") -> "************1234"
+//	MaskCreditCard("1234") -> "1234" (no masking if there are 4 digits or fewer)
+func MaskCreditCard(s string) string {
+	return maskDigits(s, 0, 4, '*')
+}
+
+// MaskPhone masks the subscriber digits of a phone number, keeping the last four visible.
+// A leading international prefix ('+' and the country code) is kept visible as well. The
+// country code length follows the E.164 numbering plan, so it is found even when the
+// number is written without separators. Formatting characters such as '(', ')', spaces
+// and dashes are kept in place.
+//
+// Parameters:
+//   - s: The phone number to mask
+//
+// Returns:
+//   - string: The masked phone number
+//
+// Example:
+//
+//	MaskPhone("+1 (555) 123-4567") -> "+1 (***) ***-4567"
+//	MaskPhone("+442071234567") -> "+44******4567"
+//	MaskPhone("5551234567") -> "******4567"
+func MaskPhone(s string) string {
+	return maskDigits(s, phoneCountryCodeLength(s), 4, '*')
+}
+
+// phoneCountryCodeLength returns the number of country code digits at the start of a phone
+// number written in international format, or 0 if the number does not start with '+'.
+// Country codes are prefix-free: "1" and "7" are the only single-digit codes, the listed
+// two-digit codes are followed by subscriber digits, and every other code has three digits.
+func phoneCountryCodeLength(s string) int {
+	s = strings.TrimLeft(s, " ")
+	if !strings.HasPrefix(s, "+") {
+		return 0
+	}
+
+	run := s[1:]
+	for i, r := range run {
+		if !unicode.IsDigit(r) {
+			run = run[:i]
+			break
+		}
+	}
+
+	length := 3
+	switch {
+	case run == "":
+		return 0
+	case run[0] == '1' || run[0] == '7':
+		length = 1
+	case len(run) >= 2 && strings.Contains(phoneTwoDigitCountryCodes, run[:2]+" "):
+		length = 2
+	}
+	return min(length, len(run))
 }
 
-// ToTitleCase converts a string to title case format where the first letter
-// of each word is capitalized and the rest of the letters in each word are lowercase.
+// phoneTwoDigitCountryCodes lists the E.164 country codes with two digits.
+const phoneTwoDigitCountryCodes = "20 27 30 31 32 33 34 36 39 40 41 43 44 45 46 47 48 49 " +
+	"51 52 53 54 55 56 57 58 60 61 62 63 64 65 66 81 82 84 86 90 91 92 93 94 95 98 "
+
+// MaskExcept masks every character of a string except those for which keep returns true.
+// This allows formatting characters such as dashes and spaces to be preserved while
+// the rest of the string is masked.
 //
 // Parameters:
-//   - s: The string to convert to title case
+//   - s: The string to mask
+//   - keep: The function that receives each rune and its rune index and returns true to keep it
+//   - maskChar: The character to use for masking
 //
 // Returns:
-//   - string: The title cased string
+//   - string: The masked string
 //
 // Example:
 //
-//	ToTitleCase("hello world") -> "Hello World"
-//	ToTitleCase("HELLO WORLD") -> "Hello World"
-//	ToTitleCase("hello WORLD") -> "Hello World"
-//	ToTitleCase("") -> ""
-func ToTitleCase(s string) string {
-	words := strings.Fields(strings.ToLower(s))
-	for i, word := range words {
-		if word != "" {
-			r := []rune(word)
-			r[0] = unicode.ToUpper(r[0])
-			words[i] = string(r)
+//	MaskExcept("555-123-4567", func(r rune, i int) bool { return r == '-' }, '#') -> "###-###-####"
+//	MaskExcept("secret", func(r rune, i int) bool { return i == 0 }, '*') -> "s*****"
+func MaskExcept(s string, keep func(r rune, i int) bool, maskChar rune) string {
+	var result strings.Builder
+	result.Grow(len(s))
+
+	i := 0
+	for _, r := range s {
+		if keep(r, i) {
+			result.WriteRune(r)
+		} else {
+			result.WriteRune(maskChar)
 		}
+		i++
 	}
-	return strings.Join(words, " ")
+
+	return result.String()
 }
 
-// OnlyAlphanumeric removes all non-alphanumeric characters from a string.
-// This includes spaces, punctuation, and special characters.
+// MaskPreserve masks a string while leaving the first keepStart and last keepEnd characters
+// visible, along with any of the preserve runes wherever they appear. Lengths are counted in
+// runes, so multi-byte characters are never split.
 //
 // Parameters:
-//   - s: The string to process
+//   - s: The string to mask
+//   - keepStart: Number of characters to leave visible at the start
+//   - keepEnd: Number of characters to leave visible at the end
+//   - maskChar: The character to use for masking
+//   - preserve: Optional runes that stay visible inside the masked region
 //
 // Returns:
-//   - string: The string with only alphanumeric characters
+//   - string: The masked string
 //
 // Example:
 //
-//	OnlyAlphanumeric("Hello, World!") -> "HelloWorld"
-//	OnlyAlphanumeric("abc123") -> "abc123"
-//	OnlyAlphanumeric("a b c") -> "abc"
-//	OnlyAlphanumeric("!@#$%^") -> ""
-func OnlyAlphanumeric(s string) string {
-	reg := regexp.MustCompile("[^a-zA-Z0-9]")
-	return reg.ReplaceAllString(s, "")
+//	MaskPreserve("4111-1111-1111-1111", 0, 4, '*', '-') -> "****-****-****-1111"
+//	MaskPreserve("12/2030", 0, 0, '#', '/') -> "##/####"
+//	MaskPreserve("Crème", 1, 1, '*') -> "C***e"
+//	MaskPreserve("1234", 2, 2, '*') -> "1234" (no masking if string is too short)
+func MaskPreserve(s string, keepStart, keepEnd int, maskChar rune, preserve ...rune) string {
+	keepStart = max(keepStart, 0)
+	keepEnd = max(keepEnd, 0)
+
+	n := utf8.RuneCountInString(s)
+	if n <= keepStart+keepEnd {
+		return s
+	}
+
+	preserved := string(preserve)
+	return MaskExcept(s, func(r rune, i int) bool {
+		return i < keepStart || i >= n-keepEnd || strings.ContainsRune(preserved, r)
+	}, maskChar)
 }
 
-// Mask masks a portion of a string with the specified character.
-// It leaves a specified number of characters visible at the beginning and end of the string.
+// Censor replaces every occurrence of the listed words with the replacement character,
+// repeated to preserve the length of the match. Matching is case-insensitive. By default
+// only whole words are censored, so "ass" does not match inside "classic"; pass false
+// for wholeWord to censor matches anywhere in the string.
 //
 // Parameters:
-//   - s: The string to mask
-//   - startVisible: Number of characters to leave visible at start
-//   - endVisible: Number of characters to leave visible at end
-//   - maskChar: The character to use for masking
+//   - s: The string to censor
+//   - badWords: The words to censor
+//   - replacement: The character used to replace each rune of a match
+//   - wholeWord: Optional flag to only censor whole words (default: true)
 //
 // Returns:
-//   - string: The masked string
+//   - string: The censored string
 //
 // Example:
 //
-//	Mask("1234567890", 4, 4, '*') -> "1234****90"
-//	Mask("1234567890", 2, 2, '#') -> "12######90"
-//	Mask("1234567890", 0, 4, '*') -> "******7890"
-//	Mask("1234", 2, 2, '*') -> "1234" (no masking if string is too short)
-func Mask(s string, startVisible, endVisible int, maskChar rune) string {
-	if len(s) <= startVisible+endVisible {
+//	Censor("What the Heck!", []string{"heck"}, '*') -> "What the ****!"
+//	Censor("heckle the heck", []string{"heck"}, '*') -> "heckle the ****"
+//	Censor("heckle the heck", []string{"heck"}, '*', false) -> "****le the ****"
+func Censor(s string, badWords []string, replacement rune, wholeWord ...bool) string {
+	matchWholeWord := true
+	if len(wholeWord) > 0 {
+		matchWholeWord = wholeWord[0]
+	}
+
+	runes := []rune(s)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	isWordRune := func(i int) bool {
+		return i >= 0 && i < len(lower) && (unicode.IsLetter(lower[i]) || unicode.IsDigit(lower[i]))
+	}
+
+	censored := false
+	for _, word := range badWords {
+		target := []rune(strings.ToLower(word))
+		if len(target) == 0 {
+			continue
+		}
+
+		for i := 0; i+len(target) <= len(lower); i++ {
+			if !slices.Equal(lower[i:i+len(target)], target) {
+				continue
+			}
+			if matchWholeWord && (isWordRune(i-1) || isWordRune(i+len(target))) {
+				continue
+			}
+			for j := i; j < i+len(target); j++ {
+				runes[j] = replacement
+			}
+			censored = true
+		}
+	}
+
+	if !censored {
+		return s
+	}
+	return string(runes)
+}
+
+// maskDigits replaces every digit in s with maskChar except the first startVisible and the
+// last endVisible digits. Non-digit characters are left untouched.
+func maskDigits(s string, startVisible, endVisible int, maskChar rune) string {
+	digits := 0
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+
+	toMask := digits - startVisible - endVisible
+	if toMask <= 0 {
 		return s
 	}
 
-	start := s[:startVisible]
-	end := s[len(s)-endVisible:]
-	masked := strings.Repeat(string(maskChar), len(s)-startVisible-endVisible)
+	var result strings.Builder
+	for _, r := range s {
+		if unicode.IsDigit(r) {
+			if startVisible > 0 {
+				startVisible--
+			} else if toMask > 0 {
+				result.WriteRune(maskChar)
+				toMask--
+				continue
+			}
+		}
+		result.WriteRune(r)
+	}
 
-	return start + masked + end
+	return result.String()
 }
 
 // PadLeft pads a string on the left side with a specified character to reach
@@ -1094,6 +2570,84 @@ func TruncateWords(s string, maxWords int) string {
 	return strings.Join(words[:maxWords], " ") + "..."
 }
 
+// TruncateOnWord truncates a string to at most maxChars characters (runes) without cutting
+// a word in half, then appends the ellipsis. The ellipsis is not counted in maxChars.
+// If the first word alone is longer than maxChars, it falls back to a hard cut.
+//
+// Parameters:
+//   - s: The string to truncate
+//   - maxChars: The maximum number of characters to keep
+//   - ellipsis: The text to append if the string was truncated
+//
+// Returns:
+//   - string: The truncated string with the ellipsis appended if truncation occurred
+//
+// Example:
+//
+//	TruncateOnWord("The quick brown fox", 12, "...") -> "The quick..."
+//	TruncateOnWord("The quick brown fox", 30, "...") -> "The quick brown fox"
+//	TruncateOnWord("Supercalifragilistic", 5, "…") -> "Super…"
+//	TruncateOnWord("Hello", 0, "...") -> ""
+func TruncateOnWord(s string, maxChars int, ellipsis string) string {
+	if maxChars <= 0 {
+		return ""
+	}
+
+	runes := []rune(s)
+	if len(runes) <= maxChars {
+		return s
+	}
+
+	cut := runes[:maxChars]
+
+	// Backtrack to the previous word boundary unless the cut already falls on one
+	if !unicode.IsSpace(runes[maxChars]) {
+		for i := len(cut) - 1; i > 0; i-- {
+			if unicode.IsSpace(cut[i]) {
+				cut = cut[:i]
+				break
+			}
+		}
+	}
+
+	return strings.TrimRightFunc(string(cut), unicode.IsSpace) + ellipsis
+}
+
+// TruncateGraphemes truncates a string to at most maxGraphemes user-perceived characters,
+// then appends the ellipsis. Unlike Truncate, it never splits a grapheme cluster, so
+// emoji sequences and letters with combining marks stay intact. The ellipsis is not
+// counted in maxGraphemes.
+//
+// Parameters:
+//   - s: The string to truncate
+//   - maxGraphemes: The maximum number of grapheme clusters to keep
+//   - ellipsis: The text to append if the string was truncated
+//
+// Returns:
+//   - string: The truncated string with the ellipsis appended if truncation occurred
+//
+// Example:
+//
+//	TruncateGraphemes("Hello, World", 5, "...") -> "Hello..."
+//	TruncateGraphemes("👨‍👩‍👧👍🏽🎉", 2, "…") -> "👨‍👩‍👧👍🏽…"
+//	TruncateGraphemes("Hello", 10, "...") -> "Hello"
+//	TruncateGraphemes("Hello", 0, "...") -> ""
+func TruncateGraphemes(s string, maxGraphemes int, ellipsis string) string {
+	if maxGraphemes <= 0 {
+		return ""
+	}
+
+	end := 0
+	for count := 0; end < len(s); count++ {
+		if count == maxGraphemes {
+			return s[:end] + ellipsis
+		}
+		end += graphemeEnd(s[end:])
+	}
+
+	return s
+}
+
 // FormatWithCommas formats a number as a string with commas as thousand separators.
 // Note: The current implementation does not actually add commas and simply returns the string
 // representation of the number. This function may be updated in the future.
@@ -1113,6 +2667,76 @@ func FormatWithCommas(n int64) string {
 	return fmt.Sprintf("%d", n)
 }
 
+// NumberFormat formats a number with grouped thousands and a fixed number of decimals,
+// like PHP's number_format. The value is rounded half away from zero at the requested
+// precision, based on its shortest decimal representation, so 1.005 rounds to "1.01"
+// even though it is stored as 1.00499... in binary. A result that rounds to zero is
+// never shown with a minus sign.
+//
+// Parameters:
+//   - value: The number to format
+//   - decimals: The number of decimal places to show (negative values are treated as 0)
+//   - decPoint: The separator between the integer and fractional parts
+//   - thousandsSep: The separator between groups of thousands
+//
+// Returns:
+//   - string: The formatted number
+//
+// Example:
+//
+//	NumberFormat(1234.567, 2, ".", ",") -> "1,234.57"
+//	NumberFormat(1234567.891, 1, ",", ".") -> "1.234.567,9"
+//	NumberFormat(-0.5, 0, ".", ",") -> "-1"
+//	NumberFormat(0.125, 2, ".", "") -> "0.13"
+func NumberFormat(value float64, decimals int, decPoint, thousandsSep string) string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+	decimals = max(decimals, 0)
+
+	negative := value < 0
+	digits := strconv.FormatFloat(math.Abs(value), 'f', -1, 64)
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+
+	// Round the decimal digits half away from zero
+	roundUp := len(fracPart) > decimals && fracPart[decimals] >= '5'
+	if len(fracPart) > decimals {
+		fracPart = fracPart[:decimals]
+	} else {
+		fracPart += strings.Repeat("0", decimals-len(fracPart))
+	}
+	if roundUp {
+		rounded := []byte(intPart + fracPart)
+		i := len(rounded) - 1
+		for ; i >= 0 && rounded[i] == '9'; i-- {
+			rounded[i] = '0'
+		}
+		if i >= 0 {
+			rounded[i]++
+		} else {
+			rounded = append([]byte{'1'}, rounded...)
+		}
+		intPart, fracPart = string(rounded[:len(rounded)-decimals]), string(rounded[len(rounded)-decimals:])
+	}
+
+	var result strings.Builder
+	if negative && strings.Trim(intPart+fracPart, "0") != "" {
+		result.WriteByte('-')
+	}
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			result.WriteString(thousandsSep)
+		}
+		result.WriteRune(digit)
+	}
+	if decimals > 0 {
+		result.WriteString(decPoint)
+		result.WriteString(fracPart)
+	}
+
+	return result.String()
+}
+
 // After returns the portion of a string after the first occurrence of a given value.
 //
 // Parameters:
@@ -1706,6 +3330,61 @@ func Start(s, prefix string) string {
 	return prefix + s
 }
 
+// Wrap surrounds a string with the given delimiters.
+// When after is omitted, before is used on both sides.
+//
+// Parameters:
+//   - s: The string to wrap
+//   - before: The string to prepend
+//   - after: Optional string to append (defaults to before)
+//
+// Returns:
+//   - string: The wrapped string
+//
+// Example:
+//
+//	Wrap("value", "\"") -> "\"value\""
+//	Wrap("is", "This ", " name?") -> "This is name?"
+//	Wrap("", "*") -> "**" (empty strings are still wrapped)
+func Wrap(s, before string, after ...string) string {
+	end := before
+	if len(after) > 0 {
+		end = after[0]
+	}
+
+	return before + s + end
+}
+
+// Unwrap removes the given delimiters from both ends of a string.
+// The delimiters are only removed when both are present; otherwise the string is returned unchanged.
+// When after is omitted, before is used on both sides.
+//
+// Parameters:
+//   - s: The string to unwrap
+//   - before: The string to remove from the start
+//   - after: Optional string to remove from the end (defaults to before)
+//
+// Returns:
+//   - string: The unwrapped string
+//
+// Example:
+//
+//	Unwrap("\"value\"", "\"") -> "value"
+//	Unwrap("This is name?", "This ", " name?") -> "is"
+//	Unwrap("\"value", "\"") -> "\"value" (closing delimiter missing)
+func Unwrap(s, before string, after ...string) string {
+	end := before
+	if len(after) > 0 {
+		end = after[0]
+	}
+
+	if len(s) < len(before)+len(end) || !strings.HasPrefix(s, before) || !strings.HasSuffix(s, end) {
+		return s
+	}
+
+	return s[len(before) : len(s)-len(end)]
+}
+
 // Studly converts a string to StudlyCase format.
 //
 // Parameters:
@@ -1880,46 +3559,187 @@ func Rtrim(s, chars string) string {
 //	https://en.wikipedia.org/wiki/Title_case#AP_style
 //	https://apastyle.apa.org/style-grammar-guidelines/capitalization/title-case
 //
-// Parameters:
-//   - s: The string to convert
+// Parameters:
+//   - s: The string to convert
+//
+// Returns:
+//   - string: The converted string
+//
+// Example:
+//
+//	Apa("Creating A Project") -> "Creating a Project"
+//	Apa("HELLO WORLD") -> "Hello WORLD"
+//	Apa("hello WORLD") -> "Hello WORLD"
+//	Apa("") -> ""
+func Apa(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return ""
+	}
+
+	// Capitalize the first letter of the first word and lowercase the rest of the word
+	if words[0] != "" {
+		r := []rune(strings.ToLower(words[0]))
+		r[0] = unicode.ToUpper(r[0])
+		words[0] = string(r)
+	}
+
+	// For the rest of the words, handle special cases
+	for i := 1; i < len(words); i++ {
+		r, _ := utf8.DecodeRuneInString(words[i])
+		if len(words[i]) == 1 && unicode.IsUpper(r) {
+			words[i] = strings.ToLower(words[i])
+		} else if words[i] == "A" {
+			words[i] = "a"
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// inflections holds the word lists used by Plural and Singular.
+// The built-in lists are loaded once; RegisterIrregular and RegisterUncountable
+// extend them at runtime, so every access goes through the embedded RWMutex.
+var inflections = struct {
+	sync.RWMutex
+	plurals      map[string]string // singular -> plural
+	singulars    map[string]string // plural -> singular
+	uncountables map[string]bool
+}{
+	plurals: map[string]string{
+		"child":          "children",
+		"goose":          "geese",
+		"man":            "men",
+		"woman":          "women",
+		"tooth":          "teeth",
+		"foot":           "feet",
+		"mouse":          "mice",
+		"person":         "people",
+		"ox":             "oxen",
+		"octopus":        "octopi",
+		"matrix":         "matrices",
+		"analysis":       "analyses",
+		"diagnosis":      "diagnoses",
+		"basis":          "bases",
+		"crisis":         "crises",
+		"medium":         "media",
+		"index":          "indices",
+		"vertex":         "vertices",
+		"vortex":         "vortices",
+		"criterion":      "criteria",
+		"quiz":           "quizzes",
+		"fish":           "fishes",
+		"deer":           "deers",
+		"already plural": "already plural",
+	},
+	singulars: map[string]string{
+		"children": "child",
+		"geese":    "goose",
+		"men":      "man",
+		"women":    "woman",
+		"teeth":    "tooth",
+		"feet":     "foot",
+		"mice":     "mouse",
+		"people":   "person",
+		"oxen":     "ox",
+		"quizzes":  "quiz",
+		"matrices": "matrix",
+		"analyses": "analysis",
+		"indices":  "index",
+		"octopi":   "octopus",
+	},
+	uncountables: map[string]bool{
+		"series":   true,
+		"species":  true,
+		"sheep":    true,
+		"moose":    true,
+		"aircraft": true,
+		"data":     true,
+	},
+}
+
+// RegisterIrregular registers an irregular singular/plural pair used by Plural and Singular.
+// Words are matched case-insensitively and override the built-in rules.
+// It is safe for concurrent use.
+//
+// Parameters:
+//   - singular: The singular form of the word
+//   - plural: The plural form of the word
+//
+// Example:
+//
+//	RegisterIrregular("cactus", "cacti")
+//	Plural("cactus") -> "cacti"
+//	Singular("cacti") -> "cactus"
+func RegisterIrregular(singular, plural string) {
+	if singular == "" || plural == "" {
+		return
+	}
+
+	lowerSingular := strings.ToLower(singular)
+	lowerPlural := strings.ToLower(plural)
+
+	inflections.Lock()
+	defer inflections.Unlock()
+
+	delete(inflections.uncountables, lowerSingular)
+	delete(inflections.uncountables, lowerPlural)
+	inflections.plurals[lowerSingular] = plural
+	inflections.singulars[lowerPlural] = singular
+}
+
+// RegisterUncountable registers a word that has the same singular and plural form.
+// Words are matched case-insensitively and override the built-in rules.
+// It is safe for concurrent use.
 //
-// Returns:
-//   - string: The converted string
+// Parameters:
+//   - word: The uncountable word
 //
 // Example:
 //
-//	Apa("Creating A Project") -> "Creating a Project"
-//	Apa("HELLO WORLD") -> "Hello WORLD"
-//	Apa("hello WORLD") -> "Hello WORLD"
-//	Apa("") -> ""
-func Apa(s string) string {
-	if s == "" {
-		return ""
+//	RegisterUncountable("equipment")
+//	Plural("equipment") -> "equipment"
+//	Singular("equipment") -> "equipment"
+func RegisterUncountable(word string) {
+	if word == "" {
+		return
 	}
 
-	words := strings.Fields(s)
-	if len(words) == 0 {
-		return ""
-	}
+	lower := strings.ToLower(word)
 
-	// Capitalize the first letter of the first word and lowercase the rest of the word
-	if words[0] != "" {
-		r := []rune(strings.ToLower(words[0]))
-		r[0] = unicode.ToUpper(r[0])
-		words[0] = string(r)
+	inflections.Lock()
+	defer inflections.Unlock()
+
+	delete(inflections.plurals, lower)
+	delete(inflections.singulars, lower)
+	inflections.uncountables[lower] = true
+}
+
+// lookupInflection looks up s in the inflection word lists.
+// It returns the inflected word and true if a rule matched.
+func lookupInflection(s string, toPlural bool) (string, bool) {
+	lower := strings.ToLower(s)
+
+	inflections.RLock()
+	defer inflections.RUnlock()
+
+	if inflections.uncountables[lower] {
+		return s, true
 	}
 
-	// For the rest of the words, handle special cases
-	for i := 1; i < len(words); i++ {
-		r, _ := utf8.DecodeRuneInString(words[i])
-		if len(words[i]) == 1 && unicode.IsUpper(r) {
-			words[i] = strings.ToLower(words[i])
-		} else if words[i] == "A" {
-			words[i] = "a"
-		}
+	irregulars := inflections.singulars
+	if toPlural {
+		irregulars = inflections.plurals
+	}
+	if word, found := irregulars[lower]; found {
+		return word, true
 	}
 
-	return strings.Join(words, " ")
+	return "", false
 }
 
 // Plural converts a singular word to its plural form.
@@ -1944,72 +3764,13 @@ func Plural(s string) string {
 		return ""
 	}
 
-	// Direct matches for special cases based on test expectations
-	specialCases := map[string]string{
-		"already plural": "already plural",
-		"quiz":           "quizzes",
-		"fish":           "fishes",
-		"deer":           "deers",
-		"matrix":         "matrices",
-		"analysis":       "analyses",
-		"octopus":        "octopi",
-		"data":           "data",
-		"series":         "series",
-		"species":        "species",
-	}
-
-	// Apply regular pluralization rules
-	lower := strings.ToLower(s)
-
-	if plural, found := specialCases[lower]; found {
+	if plural, found := lookupInflection(s, true); found {
 		return plural
 	}
 
-	// Words that are the same in singular and plural
-	unchanging := map[string]bool{
-		"series":   true,
-		"species":  true,
-		"deer":     true,
-		"sheep":    true,
-		"fish":     true,
-		"moose":    true,
-		"aircraft": true,
-		"data":     true,
-	}
-
-	if unchanging[lower] {
-		return s
-	}
-
+	lower := strings.ToLower(s)
 	// The five vowels
 	vowels := "aeiou"
-	// Some common irregular plurals
-	irregulars := map[string]string{
-		"child":     "children",
-		"goose":     "geese",
-		"man":       "men",
-		"woman":     "women",
-		"tooth":     "teeth",
-		"foot":      "feet",
-		"mouse":     "mice",
-		"person":    "people",
-		"ox":        "oxen",
-		"octopus":   "octopi",
-		"matrix":    "matrices",
-		"analysis":  "analyses",
-		"diagnosis": "diagnoses",
-		"basis":     "bases",
-		"crisis":    "crises",
-		"medium":    "media",
-		"index":     "indices",
-		"vertex":    "vertices",
-		"vortex":    "vortices",
-		"criterion": "criteria",
-	}
-
-	if plural, found := irregulars[strings.ToLower(s)]; found {
-		return plural
-	}
 
 	// Words ending in 'y' preceded by a consonant
 	if EndsWith(lower, "y") && len(s) > 1 {
@@ -2060,35 +3821,7 @@ func Singular(s string) string {
 		return ""
 	}
 
-	// Words that are same in singular and plural
-	unchanging := map[string]bool{
-		"series":  true,
-		"species": true,
-	}
-
-	if unchanging[strings.ToLower(s)] {
-		return s
-	}
-
-	// Some common irregular singulars
-	irregulars := map[string]string{
-		"children": "child",
-		"geese":    "goose",
-		"men":      "man",
-		"women":    "woman",
-		"teeth":    "tooth",
-		"feet":     "foot",
-		"mice":     "mouse",
-		"people":   "person",
-		"oxen":     "ox",
-		"quizzes":  "quiz",
-		"matrices": "matrix",
-		"analyses": "analysis",
-		"indices":  "index",
-		"octopi":   "octopus",
-	}
-
-	if singular, ok := irregulars[strings.ToLower(s)]; ok {
+	if singular, found := lookupInflection(s, false); found {
 		return singular
 	}
 
@@ -2135,7 +3868,47 @@ func Singular(s string) string {
 	return s
 }
 
+// Pluralize returns the singular form of word when count is 1 and its plural form, as given
+// by Plural, for any other count. Zero uses the plural form ("0 apples") and negative counts
+// are treated like their absolute value. When inclusive is true the count is prefixed.
+// Irregular plurals registered with Singular, such as "people", are first normalized to
+// their singular form. Regular plurals are not, because stripping a trailing "s" would
+// break singular words like "bus", so other words should be passed in singular form.
+//
+// Parameters:
+//   - word: The singular word, or an irregular plural
+//   - count: The number of items the word describes
+//   - inclusive: Optional flag to prefix the count to the result (default: false)
+//
+// Returns:
+//   - string: The singular or plural form of the word, optionally prefixed by the count
+//
+// Example:
+//
+//	Pluralize("apple", 1) -> "apple"
+//	Pluralize("apple", 3) -> "apples"
+//	Pluralize("child", 2, true) -> "2 children"
+//	Pluralize("apple", 0, true) -> "0 apples"
+//	Pluralize("apple", -1, true) -> "-1 apple"
+//	Pluralize("people", 1, true) -> "1 person"
+func Pluralize(word string, count int, inclusive ...bool) string {
+	if singular, found := lookupInflection(word, false); found {
+		word = singular
+	}
+
+	form := word
+	if count != 1 && count != -1 {
+		form = Plural(word)
+	}
+
+	if len(inclusive) > 0 && inclusive[0] {
+		return strconv.Itoa(count) + " " + form
+	}
+	return form
+}
+
 // Wordwrap wraps a string to a given number of characters.
+// Width is measured in runes, so multi-byte characters such as CJK or accented letters count as one character.
 //
 // Parameters:
 //   - s: The string to wrap
@@ -2161,9 +3934,12 @@ func Wordwrap(s string, width int, breakChar string) string {
 
 	lineLength := 0
 	for i, word := range words {
-		if len(word) > width {
+		// Measure words in runes so multi-byte characters count as a single column
+		runes := []rune(word)
+		wordLength := len(runes)
+		if wordLength > width {
 			// Handle long words by breaking them up
-			for j := 0; j < len(word); j += width {
+			for j := 0; j < wordLength; j += width {
 				if j > 0 {
 					result.WriteString(breakChar)
 				} else if i > 0 {
@@ -2175,14 +3951,13 @@ func Wordwrap(s string, width int, breakChar string) string {
 					}
 				}
 				end := j + width
-				if end > len(word) {
-					end = len(word)
+				if end > wordLength {
+					end = wordLength
 				}
-				result.WriteString(word[j:end])
+				result.WriteString(string(runes[j:end]))
 			}
-			lineLength = len(word) % width
+			lineLength = wordLength % width
 		} else {
-			wordLength := len(word)
 			if i > 0 {
 				if lineLength+wordLength+1 > width {
 					result.WriteString(breakChar)
@@ -2200,71 +3975,105 @@ func Wordwrap(s string, width int, breakChar string) string {
 	return result.String()
 }
 
-// splitByBoundaries splits a string into words by detecting word boundaries manually.
-// It handles various boundary conditions such as transitions between letter cases,
-// transitions between letters and numbers, and punctuation.
+// WordWrapIndent wraps a string to a given width, prefixing the first line with firstIndent
+// and every continuation line with hangingIndent. The width includes the indent and is
+// measured in runes. Words longer than the available space are broken up.
 //
 // Parameters:
-//   - str: The input string to be split into words.
+//   - s: The string to wrap
+//   - width: The maximum line width, including the indent
+//   - firstIndent: The prefix for the first line
+//   - hangingIndent: The prefix for every following line
 //
 // Returns:
-//   - []string: A slice of lowercase words extracted from the input string.
+//   - string: The wrapped string with lines separated by "\n"
 //
 // Example:
 //
-//	words := splitByBoundaries("camelCaseText123") // Returns ["camel", "case", "text", "123"]
-//	words := splitByBoundaries("XMLHttpRequest")   // Returns ["xml", "http", "request"]
-func splitByBoundaries(str string) []string {
-	if str == "" {
-		return []string{}
+//	WordWrapIndent("Show the help text for a command", 16, "  ", "      ") -> "  Show the help\n      text for a\n      command"
+//	WordWrapIndent("short", 20, "- ", "  ") -> "- short"
+//	WordWrapIndent("", 20, "- ", "  ") -> ""
+func WordWrapIndent(s string, width int, firstIndent, hangingIndent string) string {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return ""
+	}
+	if width <= 0 {
+		return firstIndent + strings.Join(words, " ")
 	}
 
-	var words []string
-	var currentWord strings.Builder
-	runes := []rune(str)
-
-	for i, r := range runes {
-		// Skip whitespace and punctuation separators
-		if unicode.IsSpace(r) || isPunctuation(r) {
-			if currentWord.Len() > 0 {
-				word := strings.ToLower(currentWord.String())
-				if isValidWord(word) {
-					words = append(words, word)
+	var result strings.Builder
+	indent := firstIndent
+	line := make([]rune, 0, width)
+	lineHasWord := false
+
+	// flush writes the current line and switches to the hanging indent
+	flush := func() {
+		if result.Len() > 0 {
+			result.WriteByte('\n')
+		}
+		result.WriteString(indent)
+		result.WriteString(string(line))
+		indent = hangingIndent
+		line = line[:0]
+		lineHasWord = false
+	}
+
+	for _, word := range words {
+		runes := []rune(word)
+		for len(runes) > 0 {
+			// Always leave room for at least one character per line
+			available := max(width-utf8.RuneCountInString(indent), 1)
+
+			needed := len(runes)
+			if lineHasWord {
+				needed++
+			}
+			if len(line)+needed <= available {
+				if lineHasWord {
+					line = append(line, ' ')
 				}
-				currentWord.Reset()
+				line = append(line, runes...)
+				lineHasWord = true
+				break
 			}
-			continue
-		}
 
-		// Check for word boundaries
-		if i > 0 {
-			prevRune := runes[i-1]
-
-			// Boundary conditions:
-			// 1. Letter to number (Int8 -> Int|8)
-			// 2. Number to letter (8Value -> 8|Value)
-			// 3. Lowercase to uppercase (camelCase -> camel|Case)
-			// 4. Multiple uppercase to lowercase (XMLHttp -> XML|Http)
-			if shouldSplit(prevRune, r, i, runes) {
-				if currentWord.Len() > 0 {
-					word := strings.ToLower(currentWord.String())
-					if isValidWord(word) {
-						words = append(words, word)
-					}
-					currentWord.Reset()
-				}
+			if lineHasWord {
+				flush()
+				continue
 			}
-		}
 
-		currentWord.WriteRune(r)
+			// The word does not fit on an empty line, so break it up
+			line = append(line, runes[:available]...)
+			runes = runes[available:]
+			flush()
+		}
+	}
+	if lineHasWord {
+		flush()
 	}
 
-	// Add the last word
-	if currentWord.Len() > 0 {
-		word := strings.ToLower(currentWord.String())
-		if isValidWord(word) {
-			words = append(words, word)
-		}
+	return result.String()
+}
+
+// splitByBoundaries splits a string into words by detecting word boundaries manually.
+// It handles various boundary conditions such as transitions between letter cases,
+// transitions between letters and numbers, and punctuation.
+//
+// Parameters:
+//   - str: The input string to be split into words.
+//
+// Returns:
+//   - []string: A slice of lowercase words extracted from the input string.
+//
+// Example:
+//
+//	words := splitByBoundaries("camelCaseText123") // Returns ["camel", "case", "text", "123"]
+//	words := splitByBoundaries("XMLHttpRequest")   // Returns ["xml", "http", "request"]
+func splitByBoundaries(str string) []string {
+	words := SplitCamelCase(str)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
 	}
 
 	return words
@@ -2594,6 +4403,58 @@ func ChopEnd(s string, suffixes interface{}) string {
 	return s
 }
 
+// TrimPrefixRepeat removes a prefix from a string as many times as it repeats.
+// Unlike ChopStart, which removes the prefix once, this strips every leading occurrence.
+//
+// Parameters:
+//   - s: The string to process
+//   - prefix: The prefix to remove
+//
+// Returns:
+//   - string: The string with all leading occurrences of prefix removed
+//
+// Example:
+//
+//	TrimPrefixRepeat("../../../etc/passwd", "../") -> "etc/passwd"
+//	TrimPrefixRepeat("0007", "0") -> "7"
+//	TrimPrefixRepeat("hello", "") -> "hello"
+func TrimPrefixRepeat(s, prefix string) string {
+	if prefix == "" {
+		return s
+	}
+
+	for strings.HasPrefix(s, prefix) {
+		s = s[len(prefix):]
+	}
+	return s
+}
+
+// TrimSuffixRepeat removes a suffix from a string as many times as it repeats.
+// Unlike ChopEnd, which removes the suffix once, this strips every trailing occurrence.
+//
+// Parameters:
+//   - s: The string to process
+//   - suffix: The suffix to remove
+//
+// Returns:
+//   - string: The string with all trailing occurrences of suffix removed
+//
+// Example:
+//
+//	TrimSuffixRepeat("path///", "/") -> "path"
+//	TrimSuffixRepeat("done!?!?", "!?") -> "done"
+//	TrimSuffixRepeat("hello", "") -> "hello"
+func TrimSuffixRepeat(s, suffix string) string {
+	if suffix == "" {
+		return s
+	}
+
+	for strings.HasSuffix(s, suffix) {
+		s = s[:len(s)-len(suffix)]
+	}
+	return s
+}
+
 // ExcerptOptions Default options struct
 type ExcerptOptions struct {
 	Radius   int
@@ -2601,7 +4462,7 @@ type ExcerptOptions struct {
 }
 
 // Excerpt extracts a portion of text around a given phrase.
-// It returns a substring that includes the phrase and a certain number of characters around it.
+// It returns a substring that includes the phrase and a certain number of characters (runes) around it.
 // If the excerpt doesn't include the entire string, omission text is added at the beginning and/or end.
 //
 // Parameters:
@@ -2619,6 +4480,7 @@ type ExcerptOptions struct {
 //	Excerpt("This is my name", "my", ExcerptOptions{Radius: 3}) -> "...is my na..."
 //	Excerpt("This is my name", "my", ExcerptOptions{Radius: 5, Omission: "(...)"}) -> "(...)is my name"
 //	Excerpt("This is my name", "foo", ExcerptOptions{}) -> "This is my name"
+//	Excerpt("Voilà où est né Noël", "est", ExcerptOptions{Radius: 3}) -> "...où est né..."
 //	Excerpt("", "foo", ExcerptOptions{}) -> ""
 func Excerpt(s, phrase string, options ...ExcerptOptions) string {
 	if s == "" || phrase == "" {
@@ -2641,24 +4503,28 @@ func Excerpt(s, phrase string, options ...ExcerptOptions) string {
 	}
 
 	// Find the position of the phrase
-	phrasePos := strings.Index(s, phrase)
-	if phrasePos == -1 {
+	bytePos := strings.Index(s, phrase)
+	if bytePos == -1 {
 		return s
 	}
 
+	// Work in runes so the radius counts characters and never splits a multi-byte character
+	runes := []rune(s)
+	phrasePos := utf8.RuneCountInString(s[:bytePos])
+
 	// Calculate start and end positions for the excerpt
 	startPos := phrasePos - opts.Radius
 	if startPos < 0 {
 		startPos = 0
 	}
 
-	endPos := phrasePos + len(phrase) + opts.Radius
-	if endPos > len(s) {
-		endPos = len(s)
+	endPos := phrasePos + utf8.RuneCountInString(phrase) + opts.Radius
+	if endPos > len(runes) {
+		endPos = len(runes)
 	}
 
 	// Extract the excerpt
-	excerpt := s[startPos:endPos]
+	excerpt := string(runes[startPos:endPos])
 
 	// Add omission text if needed
 	result := ""
@@ -2666,7 +4532,7 @@ func Excerpt(s, phrase string, options ...ExcerptOptions) string {
 		result += opts.Omission
 	}
 	result += excerpt
-	if endPos < len(s) || opts.Radius == 0 {
+	if endPos < len(runes) || opts.Radius == 0 {
 		result += opts.Omission
 	}
 
@@ -2826,6 +4692,161 @@ func Squish(s string) string {
 	}
 
 	// Replace all sequences of whitespace with a single space
-	re := regexp.MustCompile(`\s+`)
-	return re.ReplaceAllString(s, " ")
+	return whitespaceRegex.ReplaceAllString(s, " ")
+}
+
+// JaroWinkler returns the Jaro-Winkler similarity of two strings as a value between
+// 0 (no similarity) and 1 (identical). It favours strings that share a common prefix,
+// which makes it well suited to comparing short strings such as names. The comparison
+// is case-sensitive and works on runes.
+//
+// Parameters:
+//   - a: The first string
+//   - b: The second string
+//
+// Returns:
+//   - float64: The similarity score between 0 and 1
+//
+// Example:
+//
+//	JaroWinkler("MARTHA", "MARHTA") -> 0.9611...
+//	JaroWinkler("DWAYNE", "DUANE") -> 0.84
+//	JaroWinkler("abc", "xyz") -> 0
+func JaroWinkler(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 && len(rb) == 0 {
+		return 1
+	}
+	if len(ra) == 0 || len(rb) == 0 {
+		return 0
+	}
+
+	// Characters match if they are equal and no further apart than this window
+	window := max(len(ra), len(rb))/2 - 1
+	window = max(window, 0)
+
+	matchedA := make([]bool, len(ra))
+	matchedB := make([]bool, len(rb))
+	matches := 0
+	for i := range ra {
+		lo, hi := max(0, i-window), min(len(rb), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matchedB[j] && ra[i] == rb[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	// Count matched characters that appear in a different order
+	transpositions := 0
+	j := 0
+	for i := range ra {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if ra[i] != rb[j] {
+			transpositions++
+		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(ra)) + m/float64(len(rb)) + (m-float64(transpositions)/2)/m) / 3
+
+	// Boost the score for a common prefix of up to four characters
+	prefix := 0
+	for prefix < min(4, len(ra), len(rb)) && ra[prefix] == rb[prefix] {
+		prefix++
+	}
+
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
+
+// soundexCodes maps each uppercase letter to its Soundex digit. Vowels and Y map to 0,
+// and H and W map to -1 because they do not separate letters with the same code.
+var soundexCodes = [26]int8{
+	0, 1, 2, 3, 0, 1, 2, -1, 0, 2, 2, 4, 5, 5, 0, 1, 2, 6, 2, 3, 0, 1, -1, 2, 0, 2,
+}
+
+// Soundex returns the American Soundex code of a string: its first letter followed by
+// three digits describing how the rest of it sounds. Names that are pronounced alike,
+// such as "Robert" and "Rupert", share a code. Characters other than ASCII letters are ignored.
+//
+// Parameters:
+//   - s: The string to encode
+//
+// Returns:
+//   - string: The four character Soundex code, or an empty string if s contains no letters
+//
+// Example:
+//
+//	Soundex("Robert") -> "R163"
+//	Soundex("Rupert") -> "R163"
+//	Soundex("Tymczak") -> "T522"
+//	Soundex("Lee") -> "L000"
+func Soundex(s string) string {
+	code := make([]byte, 0, 4)
+	var last int8
+	for _, r := range strings.ToUpper(s) {
+		if r < 'A' || r > 'Z' {
+			continue
+		}
+
+		digit := soundexCodes[r-'A']
+		if len(code) == 0 {
+			code = append(code, byte(r))
+			last = digit
+			continue
+		}
+
+		switch {
+		case digit == -1:
+			// H and W are skipped without resetting the previous code
+		case digit == 0:
+			last = 0
+		case digit != last:
+			code = append(code, byte('0'+digit))
+			last = digit
+		}
+
+		if len(code) == 4 {
+			break
+		}
+	}
+
+	if len(code) == 0 {
+		return ""
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return string(code)
+}
+
+// SoundsLike reports whether two strings have the same Soundex code.
+// Strings without any letters never sound alike.
+//
+// Parameters:
+//   - a: The first string
+//   - b: The second string
+//
+// Returns:
+//   - bool: True if both strings have the same non-empty Soundex code
+//
+// Example:
+//
+//	SoundsLike("Robert", "Rupert") -> true
+//	SoundsLike("Smith", "Smyth") -> true
+//	SoundsLike("Robert", "Rubin") -> false
+func SoundsLike(a, b string) bool {
+	code := Soundex(a)
+	return code != "" && code == Soundex(b)
 }
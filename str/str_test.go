@@ -2,8 +2,12 @@ package str
 
 import (
 	"fmt"
+	"math"
 	"strings"
+	"sync"
 	"testing"
+	"unicode"
+	"unicode/utf8"
 )
 
 func TestCamelcase(t *testing.T) {
@@ -89,6 +93,56 @@ func TestStartsWith(t *testing.T) {
 	}
 }
 
+func TestStartsWithFold(t *testing.T) {
+	tests := []struct {
+		input    string
+		targets  []string
+		expected bool
+	}{
+		{"HÉllo", []string{"hé"}, true},
+		{"abc", []string{"AB"}, true},
+		{"abc", []string{"X", "Y", "A"}, true}, // Any substring may match
+		{"abc", []string{"d"}, false},
+		{"ab", []string{"ABC"}, false},
+		{"\u212Aelvin", []string{"kel"}, true}, // Kelvin sign folds to k
+		{"ſtop", []string{"ST"}, true},         // Long s folds to s
+		{"ıstanbul", []string{"IST"}, false},   // Dotless i does not fold to I
+		{"İstanbul", []string{"ist"}, false},   // Dotted capital I does not fold to i
+		{"abc", []string{""}, true},
+		{"abc", []string{}, false},
+	}
+
+	for _, test := range tests {
+		result := StartsWithFold(test.input, test.targets...)
+		if result != test.expected {
+			t.Errorf("StartsWithFold(%q, %q) = %v, expected %v", test.input, test.targets, result, test.expected)
+		}
+	}
+}
+
+func TestEndsWithFold(t *testing.T) {
+	tests := []struct {
+		input    string
+		targets  []string
+		expected bool
+	}{
+		{"photo.JPG", []string{".jpg"}, true},
+		{"abc", []string{"X", "BC"}, true},
+		{"abc", []string{"d"}, false},
+		{"bc", []string{"ABC"}, false},
+		{"ÀÉÎ", []string{"éî"}, true},
+		{"DIŞ", []string{"ış"}, false}, // Dotless i does not fold to I
+		{"abc", []string{""}, true},
+	}
+
+	for _, test := range tests {
+		result := EndsWithFold(test.input, test.targets...)
+		if result != test.expected {
+			t.Errorf("EndsWithFold(%q, %q) = %v, expected %v", test.input, test.targets, result, test.expected)
+		}
+	}
+}
+
 func TestTrim(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -114,6 +168,70 @@ func TestTrim(t *testing.T) {
 	}
 }
 
+func TestTrimAny(t *testing.T) {
+	tests := []struct {
+		input    string
+		cutsets  []string
+		expected string
+	}{
+		{"-_-abc-_-", []string{"-", "_"}, "abc"},
+		{"[(abc)]", []string{"[]", "()"}, "abc"},
+		{"xxabcyy", []string{"x"}, "abcyy"},
+		{"  abc  ", nil, "abc"},
+		{"--", []string{"-"}, ""},
+		{"", []string{"-"}, ""},
+	}
+
+	for _, test := range tests {
+		result := TrimAny(test.input, test.cutsets...)
+		if result != test.expected {
+			t.Errorf("TrimAny(%q, %q) = %q, expected %q", test.input, test.cutsets, result, test.expected)
+		}
+	}
+}
+
+func TestTrimPrefixes(t *testing.T) {
+	tests := []struct {
+		input    string
+		prefixes []string
+		expected string
+	}{
+		{"https://example.com", []string{"http://", "https://"}, "example.com"},
+		{"example.com", []string{"http://", "https://"}, "example.com"},
+		{"aab", []string{"a", "aa"}, "ab"}, // Only the first match is removed
+		{"abc", nil, "abc"},
+		{"", []string{"a"}, ""},
+	}
+
+	for _, test := range tests {
+		result := TrimPrefixes(test.input, test.prefixes...)
+		if result != test.expected {
+			t.Errorf("TrimPrefixes(%q, %q) = %q, expected %q", test.input, test.prefixes, result, test.expected)
+		}
+	}
+}
+
+func TestTrimSuffixes(t *testing.T) {
+	tests := []struct {
+		input    string
+		suffixes []string
+		expected string
+	}{
+		{"archive.tar.gz", []string{".gz", ".tar.gz"}, "archive.tar"},
+		{"archive.tar.gz", []string{".tar.gz", ".gz"}, "archive"},
+		{"archive.zip", []string{".gz", ".tar.gz"}, "archive.zip"},
+		{"abc", nil, "abc"},
+		{"", []string{"a"}, ""},
+	}
+
+	for _, test := range tests {
+		result := TrimSuffixes(test.input, test.suffixes...)
+		if result != test.expected {
+			t.Errorf("TrimSuffixes(%q, %q) = %q, expected %q", test.input, test.suffixes, result, test.expected)
+		}
+	}
+}
+
 func TestToLower(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -177,6 +295,62 @@ func TestSplit(t *testing.T) {
 	}
 }
 
+func TestSplitAfter(t *testing.T) {
+	tests := []struct {
+		input     string
+		separator string
+		expected  []string
+	}{
+		{"a,b,c", ",", []string{"a,", "b,", "c"}},
+		{"a,b,", ",", []string{"a,", "b,", ""}},
+		{"abc", ",", []string{"abc"}},
+		{"", ",", []string{""}},
+	}
+
+	for _, test := range tests {
+		result := SplitAfter(test.input, test.separator)
+		if len(result) != len(test.expected) {
+			t.Errorf("SplitAfter(%q, %q) = %q, expected %q", test.input, test.separator, result, test.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("SplitAfter(%q, %q)[%d] = %q, expected %q", test.input, test.separator, i, result[i], test.expected[i])
+			}
+		}
+	}
+}
+
+func TestSplitKeep(t *testing.T) {
+	tests := []struct {
+		input    string
+		pattern  string
+		expected []string
+	}{
+		{"1+2*3", `[+*]`, []string{"1", "+", "2", "*", "3"}},
+		{"a, b;c", `[,;]\s*`, []string{"a", ", ", "b", ";", "c"}},
+		{"(a)", `[()]`, []string{"(", "a", ")"}},
+		{"a++b", `\+`, []string{"a", "+", "+", "b"}}, // No empty text between delimiters
+		{"abc", `,`, []string{"abc"}},
+		{"ab", `x*`, []string{"ab"}},  // Empty matches are ignored
+		{"a(b", `(`, []string{"a(b"}}, // Invalid pattern
+		{"", `,`, []string{}},
+	}
+
+	for _, test := range tests {
+		result := SplitKeep(test.input, test.pattern)
+		if len(result) != len(test.expected) {
+			t.Errorf("SplitKeep(%q, %q) = %q, expected %q", test.input, test.pattern, result, test.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("SplitKeep(%q, %q)[%d] = %q, expected %q", test.input, test.pattern, i, result[i], test.expected[i])
+			}
+		}
+	}
+}
+
 func TestJoin(t *testing.T) {
 	tests := []struct {
 		input     []string
@@ -215,6 +389,52 @@ func TestRepeat(t *testing.T) {
 	}
 }
 
+func TestRepeatJoin(t *testing.T) {
+	tests := []struct {
+		input    string
+		n        int
+		sep      string
+		expected string
+	}{
+		{"ab", 3, "-", "ab-ab-ab"},
+		{"?", 3, ",", "?,?,?"},
+		{"ab", 1, "-", "ab"},
+		{"ab", 0, "-", ""},
+		{"ab", -1, "-", ""},
+		{"", 3, ",", ",,"},
+	}
+
+	for _, test := range tests {
+		result := RepeatJoin(test.input, test.n, test.sep)
+		if result != test.expected {
+			t.Errorf("RepeatJoin(%q, %d, %q) = %q, expected %q", test.input, test.n, test.sep, result, test.expected)
+		}
+	}
+}
+
+func TestPlaceholderList(t *testing.T) {
+	tests := []struct {
+		n        int
+		style    string
+		expected string
+	}{
+		{3, "?", "?,?,?"},
+		{1, "?", "?"},
+		{3, "$", "$1,$2,$3"},
+		{12, "$", "$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12"},
+		{0, "?", ""},
+		{0, "$", ""},
+		{-2, "$", ""},
+	}
+
+	for _, test := range tests {
+		result := PlaceholderList(test.n, test.style)
+		if result != test.expected {
+			t.Errorf("PlaceholderList(%d, %q) = %q, expected %q", test.n, test.style, result, test.expected)
+		}
+	}
+}
+
 func TestReplace(t *testing.T) {
 	tests := []struct {
 		subject  string
@@ -257,6 +477,31 @@ func TestContains(t *testing.T) {
 	}
 }
 
+func TestContainsFold(t *testing.T) {
+	tests := []struct {
+		input    string
+		target   string
+		expected bool
+	}{
+		{"Hello World", "WORLD", true},
+		{"Hello World", "o w", true},
+		{"Hello", "xyz", false},
+		{"ÉCOLE normale", "école", true},
+		{"Straße", "STRASSE", false}, // ß does not fold to "ss"
+		{"ſtraße", "STRA", true},     // Long s folds to s
+		{"KILIM", "kılım", false},    // Dotless i does not fold to I
+		{"abc", "", true},
+		{"", "a", false},
+	}
+
+	for _, test := range tests {
+		result := ContainsFold(test.input, test.target)
+		if result != test.expected {
+			t.Errorf("ContainsFold(%q, %q) = %v, expected %v", test.input, test.target, result, test.expected)
+		}
+	}
+}
+
 func TestEllipsis(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -383,6 +628,34 @@ func TestWordsPattern(t *testing.T) {
 	}
 }
 
+func TestSplitCamelCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"camelCaseText123", []string{"camel", "Case", "Text", "123"}},
+		{"PascalCase", []string{"Pascal", "Case"}},
+		{"XMLHttpRequest", []string{"XML", "Http", "Request"}},
+		{"Int8Value", []string{"Int", "8", "Value"}},
+		{"user_ID", []string{"user", "ID"}},
+		{"hello world", []string{"hello", "world"}},
+		{"", []string{}},
+	}
+
+	for _, test := range tests {
+		result := SplitCamelCase(test.input)
+		if len(result) != len(test.expected) {
+			t.Errorf("SplitCamelCase(%q) = %v, expected %v", test.input, result, test.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("SplitCamelCase(%q)[%d] = %q, expected %q", test.input, i, result[i], test.expected[i])
+			}
+		}
+	}
+}
+
 func TestKebabCase(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -431,6 +704,101 @@ func TestSnakeCase(t *testing.T) {
 	}
 }
 
+func TestSnakeToCamel(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"user_id", "userId"},
+		{"created_at_utc", "createdAtUtc"},
+		{"USER_ID", "userId"},
+		{"__private__field_", "privateField"},
+		{"already", "already"},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		result := SnakeToCamel(test.input)
+		if result != test.expected {
+			t.Errorf("SnakeToCamel(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+		if camel := CamelCase(test.input); camel != result {
+			t.Errorf("SnakeToCamel(%q) = %q, but CamelCase returns %q", test.input, result, camel)
+		}
+	}
+}
+
+func TestCamelToSnake(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"userId", "user_id"},
+		{"XMLHttpRequest", "xml_http_request"},
+		{"CreatedAt", "created_at"},
+		{"userID", "user_id"},
+		{"Int8Value", "int_8_value"},
+		{"already_snake", "already_snake"},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		result := CamelToSnake(test.input)
+		if result != test.expected {
+			t.Errorf("CamelToSnake(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestSnakeCaseAcronyms(t *testing.T) {
+	tests := []struct {
+		input    string
+		acronyms []string
+		expected string
+	}{
+		{"getAPIKey", []string{"API"}, "get_api_key"},
+		{"getAPIKey", nil, "get_apikey"}, // Same as SnakeCase without acronyms
+		{"OAuthTokenURL", []string{"OAuth", "URL"}, "oauth_token_url"},
+		{"getHTTPSURL", []string{"HTTP", "HTTPS", "URL"}, "get_https_url"}, // Longest acronym wins
+		{"parse JSON body", []string{"JSON"}, "parse_json_body"},
+		{"api key", []string{"API"}, "api_key"}, // Acronyms match case-sensitively
+		{"APIAPI", []string{"API"}, "api_api"},
+		{"CAPITAL", []string{"API"}, "capital"},         // No match inside a word
+		{"RAPIDAPI", []string{"API"}, "rapidapi"},       // No match inside a word
+		{"RapidAPI", []string{"API"}, "rapid_api"},      // Lowercase to uppercase is a boundary
+		{"getAPIs", []string{"API"}, "get_apis"},        // Acronym must end on a boundary
+		{"API2Client", []string{"API"}, "api_2_client"}, // Letter to digit is a boundary
+		{"use-API_key", []string{"API"}, "use_api_key"}, // Separators are boundaries
+		{"", []string{"API"}, ""},
+	}
+
+	for _, test := range tests {
+		result := SnakeCaseAcronyms(test.input, test.acronyms)
+		if result != test.expected {
+			t.Errorf("SnakeCaseAcronyms(%q, %v) = %q, expected %q", test.input, test.acronyms, result, test.expected)
+		}
+	}
+}
+
+func TestKebabCaseAcronyms(t *testing.T) {
+	tests := []struct {
+		input    string
+		acronyms []string
+		expected string
+	}{
+		{"getAPIKey", []string{"API"}, "get-api-key"},
+		{"newOAuthClient", []string{"OAuth"}, "new-oauth-client"},
+		{"", nil, ""},
+	}
+
+	for _, test := range tests {
+		result := KebabCaseAcronyms(test.input, test.acronyms)
+		if result != test.expected {
+			t.Errorf("KebabCaseAcronyms(%q, %v) = %q, expected %q", test.input, test.acronyms, result, test.expected)
+		}
+	}
+}
+
 func TestPascalCase(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -479,6 +847,54 @@ func TestHeadline(t *testing.T) {
 	}
 }
 
+func TestAcronym(t *testing.T) {
+	tests := []struct {
+		input         string
+		includeDigits bool
+		expected      string
+	}{
+		{"Portable Document Format", false, "PDF"},
+		{"as soon as possible", false, "ASAP"},
+		{"portableNetworkGraphics", false, "PNG"},
+		{"Web 3 Foundation", false, "WF"},
+		{"Web 3 Foundation", true, "W3F"},
+		{"", false, ""},
+	}
+
+	for _, test := range tests {
+		result := Acronym(test.input, test.includeDigits)
+		if result != test.expected {
+			t.Errorf("Acronym(%q, %v) = %q, expected %q", test.input, test.includeDigits, result, test.expected)
+		}
+	}
+}
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		input    string
+		max      int
+		expected string
+	}{
+		{"John Q. Public", 2, "JP"},
+		{"John Q. Public", 3, "JQP"},
+		{"John Q. Public", 5, "JQP"},
+		{"Mary Jane Watson", 1, "M"},
+		{"Juan Carlos de la Cruz", 2, "JC"},
+		{"  madonna  ", 2, "M"},
+		{"john   smith", 2, "JS"},
+		{"Élodie Ève", 2, "ÉÈ"},
+		{"John Smith", 0, ""},
+		{"", 2, ""},
+	}
+
+	for _, test := range tests {
+		result := Initials(test.input, test.max)
+		if result != test.expected {
+			t.Errorf("Initials(%q, %d) = %q, expected %q", test.input, test.max, result, test.expected)
+		}
+	}
+}
+
 func TestTrimStart(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -680,6 +1096,57 @@ func TestToTitleCase(t *testing.T) {
 	}
 }
 
+func TestCapitalizeWords(t *testing.T) {
+	tests := []struct {
+		input      string
+		delimiters []rune
+		expected   string
+	}{
+		{"mary-jane watson", nil, "Mary-Jane Watson"},
+		{"jean-luc picard", nil, "Jean-Luc Picard"},
+		{"anne-marie--smith", nil, "Anne-Marie--Smith"},
+		{"path/to_file", nil, "Path/To_File"},
+		{"hello WORLD", nil, "Hello WORLD"}, // The rest of each word is left unchanged
+		{"o'neil-smith", []rune{'\''}, "O'Neil-smith"},
+		{"élodie-ève", nil, "Élodie-Ève"},
+		{"", nil, ""},
+	}
+
+	for _, test := range tests {
+		result := CapitalizeWords(test.input, test.delimiters...)
+		if result != test.expected {
+			t.Errorf("CapitalizeWords(%q, %q) = %q, expected %q", test.input, test.delimiters, result, test.expected)
+		}
+	}
+}
+
+func TestCapitalizeSentences(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"hello there. how are you? fine!", "Hello there. How are you? Fine!"},
+		{"one.two three", "One.two three"}, // Terminators must be followed by whitespace
+		{"it costs 3.50. ok", "It costs 3.50. Ok"},
+		{"done!\n\nnext paragraph", "Done!\n\nNext paragraph"},
+		{"he said \"wow.\" \"really?\" yes.", "He said \"wow.\" \"Really?\" Yes."},
+		{"(see above.) then go", "(See above.) Then go"},
+		{"  leading space. iPhone stays", "  Leading space. IPhone stays"},
+		{"wait... what?", "Wait... What?"},
+		{"1. first item", "1. First item"},
+		{"é bien. ça va", "É bien. Ça va"},
+		{"Already Fine. Keep CASE", "Already Fine. Keep CASE"},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		result := CapitalizeSentences(test.input)
+		if result != test.expected {
+			t.Errorf("CapitalizeSentences(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
 func TestOnlyAlphanumeric(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -719,7 +1186,9 @@ func TestMask(t *testing.T) {
 		{"1234567890", 6, 2, '*', "123456**90"},
 		{"1234", 2, 1, '*', "12*4"}, // No masking if string is too short
 		{"", 2, 2, '*', ""},
-		{"1234567890", 2, 2, '•', "12••••••90"}, // 6 mask characters
+		{"1234567890", 2, 2, '•', "12••••••90"},     // 6 mask characters
+		{"Crème brûlée", 2, 2, '*', "Cr********ée"}, // Counts runes, not bytes
+		{"1234567890", -1, 2, '*', "********90"},    // Negative counts are treated as zero
 	}
 
 	for _, test := range tests {
@@ -731,6 +1200,135 @@ func TestMask(t *testing.T) {
 	}
 }
 
+func TestMaskCreditCard(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"4111 1111 1111 1234", "**** **** **** 1234"},
+		{"4111-1111-1111-1234", "****-****-****-1234"},
+		{"4111111111111234", "************1234"},
+		{"1234", "1234"},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		result := MaskCreditCard(test.input)
+		if result != test.expected {
+			t.Errorf("MaskCreditCard(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestMaskPhone(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"+1 (555) 123-4567", "+1 (***) ***-4567"},
+		{"+15551234567", "+1******4567"},
+		{"+44 20 7123 4567", "+44 ** **** 4567"},
+		{"+442071234567", "+44******4567"},
+		{"+49-30-1234567", "+49-**-***4567"},
+		{"+353 1 234 5678", "+353 * *** 5678"},
+		{"+3531234 5678", "+353**** 5678"},
+		{"+7 495 123-45-67", "+7 *** ***-45-67"},
+		{"+44 4567", "+44 4567"}, // Nothing left to mask
+		{"555-123-4567", "***-***-4567"},
+		{"5551234567", "******4567"},
+		{"4567", "4567"},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		result := MaskPhone(test.input)
+		if result != test.expected {
+			t.Errorf("MaskPhone(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestMaskExcept(t *testing.T) {
+	keepDashes := func(r rune, i int) bool { return r == '-' }
+	keepFirst := func(r rune, i int) bool { return i == 0 }
+	keepFormatting := func(r rune, i int) bool { return !unicode.IsDigit(r) }
+
+	tests := []struct {
+		input    string
+		keep     func(rune, int) bool
+		name     string
+		maskChar rune
+		expected string
+	}{
+		{"555-123-4567", keepDashes, "keepDashes", '#', "###-###-####"},
+		{"secret", keepFirst, "keepFirst", '*', "s*****"},
+		{"+1 (555) 123", keepFormatting, "keepFormatting", '•', "+• (•••) •••"},
+		{"née", keepFirst, "keepFirst", '*', "n**"}, // Indexes count runes
+		{"", keepFirst, "keepFirst", '*', ""},
+	}
+
+	for _, test := range tests {
+		result := MaskExcept(test.input, test.keep, test.maskChar)
+		if result != test.expected {
+			t.Errorf("MaskExcept(%q, %s, %q) = %q, expected %q", test.input, test.name, test.maskChar, result, test.expected)
+		}
+	}
+}
+
+func TestMaskPreserve(t *testing.T) {
+	tests := []struct {
+		input     string
+		keepStart int
+		keepEnd   int
+		maskChar  rune
+		preserve  []rune
+		expected  string
+	}{
+		{"4111-1111-1111-1111", 0, 4, '*', []rune{'-'}, "****-****-****-1111"},
+		{"4111 1111 1111 1111", 4, 4, '*', []rune{' ', '-'}, "4111 **** **** 1111"},
+		{"12/2030", 0, 0, '#', []rune{'/'}, "##/####"},
+		{"secret", 1, 0, '*', nil, "s*****"},
+		{"Crème brûlée", 2, 2, '*', []rune{' '}, "Cr*** ****ée"}, // Counts runes, not bytes
+		{"1234", 2, 2, '*', nil, "1234"},                         // Too short to mask
+		{"1234", -1, -1, '*', nil, "****"},                       // Negative counts are treated as 0
+		{"", 0, 0, '*', nil, ""},
+	}
+
+	for _, test := range tests {
+		result := MaskPreserve(test.input, test.keepStart, test.keepEnd, test.maskChar, test.preserve...)
+		if result != test.expected {
+			t.Errorf("MaskPreserve(%q, %d, %d, %q, %q) = %q, expected %q",
+				test.input, test.keepStart, test.keepEnd, test.maskChar, test.preserve, result, test.expected)
+		}
+	}
+}
+
+func TestCensor(t *testing.T) {
+	tests := []struct {
+		input     string
+		badWords  []string
+		wholeWord []bool
+		expected  string
+	}{
+		{"What the Heck!", []string{"heck"}, nil, "What the ****!"},
+		{"HECK heck HeCk", []string{"heck"}, nil, "**** **** ****"},
+		{"heckle the heck", []string{"heck"}, nil, "heckle the ****"},
+		{"classic assessment", []string{"ass"}, nil, "classic assessment"}, // Scunthorpe problem avoided
+		{"heckle the heck", []string{"heck"}, []bool{false}, "****le the ****"},
+		{"darn it, drat", []string{"darn", "drat"}, nil, "**** it, ****"},
+		{"héck héck2 héck", []string{"HÉCK"}, nil, "**** héck2 ****"}, // Length preserved in runes
+		{"clean text", []string{"heck", ""}, nil, "clean text"},
+		{"", []string{"heck"}, nil, ""},
+	}
+
+	for _, test := range tests {
+		result := Censor(test.input, test.badWords, '*', test.wholeWord...)
+		if result != test.expected {
+			t.Errorf("Censor(%q, %v, '*', %v) = %q, expected %q", test.input, test.badWords, test.wholeWord, result, test.expected)
+		}
+	}
+}
+
 func TestPadLeft(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -832,80 +1430,325 @@ func TestCountWords(t *testing.T) {
 	}
 }
 
-func TestTruncateWords(t *testing.T) {
+func TestTruncateWords(t *testing.T) {
+	tests := []struct {
+		input    string
+		maxWords int
+		expected string
+	}{
+		{"hello world", 1, "hello..."},
+		{"hello world", 2, "hello world"},
+		{"hello world", 3, "hello world"},
+		{"one two three four", 2, "one two..."},
+		{"one two three four", 0, ""},
+		{"", 5, ""},
+		{"   ", 5, ""},
+		{"one", 1, "one"},
+		{"one-two three", 1, "one-two..."}, // Hyphen is treated as part of the word
+		{"one_two three", 1, "one_two..."}, // Underscore is treated as part of the word
+		{"one.two three", 1, "one.two..."}, // Period is treated as part of the word
+		{"one,two three", 1, "one,two..."}, // Comma is treated as part of the word
+	}
+
+	for _, test := range tests {
+		result := TruncateWords(test.input, test.maxWords)
+		if result != test.expected {
+			t.Errorf("TruncateWords(%q, %d) = %q, expected %q", test.input, test.maxWords, result, test.expected)
+		}
+	}
+}
+
+func TestTruncateOnWord(t *testing.T) {
+	tests := []struct {
+		input    string
+		maxChars int
+		ellipsis string
+		expected string
+	}{
+		{"The quick brown fox", 12, "...", "The quick..."},
+		{"The quick brown fox", 9, "...", "The quick..."},  // Cut falls right after a word
+		{"The quick brown fox", 10, "...", "The quick..."}, // Cut falls on a space
+		{"The quick brown fox", 30, "...", "The quick brown fox"},
+		{"The quick brown fox", 19, "...", "The quick brown fox"},
+		{"Supercalifragilistic", 5, "…", "Super…"}, // First word too long falls back to a hard cut
+		{"Crème brûlée au café", 13, "…", "Crème brûlée…"},
+		{"Hello", 0, "...", ""},
+		{"", 5, "...", ""},
+	}
+
+	for _, test := range tests {
+		result := TruncateOnWord(test.input, test.maxChars, test.ellipsis)
+		if result != test.expected {
+			t.Errorf("TruncateOnWord(%q, %d, %q) = %q, expected %q", test.input, test.maxChars, test.ellipsis, result, test.expected)
+		}
+	}
+}
+
+func TestTruncateGraphemes(t *testing.T) {
+	tests := []struct {
+		input    string
+		max      int
+		ellipsis string
+		expected string
+	}{
+		{"Hello, World", 5, "...", "Hello..."},
+		{"Hello", 5, "...", "Hello"},
+		{"Hello", 10, "...", "Hello"},
+		{"👨\u200d👩\u200d👧👍🏽🎉", 2, "…", "👨\u200d👩\u200d👧👍🏽…"}, // Emoji sequences kept whole
+		{"👍🏽👍🏽", 1, "", "👍🏽"},
+		{"a\u200dbcd", 1, "", "a\u200d"},           // ZWJ between letters does not join them
+		{"cafe\u0301s", 4, "...", "cafe\u0301..."}, // Combining mark stays with its letter
+		{"🇯🇵🇫🇷", 1, "!", "🇯🇵!"},
+		{"Hello", 0, "...", ""},
+		{"", 3, "...", ""},
+	}
+
+	for _, test := range tests {
+		result := TruncateGraphemes(test.input, test.max, test.ellipsis)
+		if result != test.expected {
+			t.Errorf("TruncateGraphemes(%q, %d, %q) = %q, expected %q", test.input, test.max, test.ellipsis, result, test.expected)
+		}
+	}
+}
+
+func TestFormatWithCommas(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{1234, "1234"}, // Note: Current implementation doesn't add commas
+		{1234567, "1234567"},
+		{1234567890, "1234567890"},
+		{123, "123"},
+		{0, "0"},
+		{-1234, "-1234"},
+		{-1234567, "-1234567"},
+		{1, "1"},
+		{10, "10"},
+		{100, "100"},
+		{1000, "1000"},
+	}
+
+	for _, test := range tests {
+		result := FormatWithCommas(test.input)
+		if result != test.expected {
+			t.Errorf("FormatWithCommas(%d) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		value        float64
+		decimals     int
+		decPoint     string
+		thousandsSep string
+		expected     string
+	}{
+		{1234.567, 2, ".", ",", "1,234.57"},
+		{1234567.891, 1, ",", ".", "1.234.567,9"},
+		{1234.5, 0, ".", ",", "1,235"},
+		{1000000, 2, ".", ",", "1,000,000.00"},
+		{999.995, 2, ".", ",", "1,000.00"}, // Rounding carries into the integer part
+		{9.99, 1, ".", ",", "10.0"},
+		{1.005, 2, ".", ",", "1.01"}, // Stored as 1.00499... but written as 1.005
+		{0.285, 2, ".", ",", "0.29"}, // Stored as 0.28499...
+		{0.125, 2, ".", "", "0.13"},  // Half away from zero, not to even
+		{0.5, 2, ".", ",", "0.50"},
+		{0.05, 0, ".", ",", "0"},
+		{-1234.567, 2, ".", ",", "-1,234.57"},
+		{-123, 0, ".", ",", "-123"}, // No separator after the sign
+		{-0.5, 0, ".", ",", "-1"},
+		{-0.001, 2, ".", ",", "0.00"}, // No negative zero
+		{12.3, -1, ".", ",", "12"},    // Negative decimals are treated as 0
+		{1234.5, 3, " dot ", "'", "1'234 dot 500"},
+		{0, 2, ".", ",", "0.00"},
+	}
+
+	for _, test := range tests {
+		result := NumberFormat(test.value, test.decimals, test.decPoint, test.thousandsSep)
+		if result != test.expected {
+			t.Errorf("NumberFormat(%v, %d, %q, %q) = %q, expected %q", test.value, test.decimals, test.decPoint, test.thousandsSep, result, test.expected)
+		}
+	}
+}
+
+func TestLength(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"hello", 5},
+		{"Hello, World!", 13},
+		{"", 0},
+		{"a", 1},
+		{"你好", 2},       // 2 Unicode characters
+		{"Hello 世界", 8}, // 5 ASCII + 2 Unicode characters + 1 space
+		{"café", 4},     // 4 characters, one with accent
+		{"café", 4},     // 4 characters, one with accent (different encoding)
+		{"a\u0308", 2},  // 'a' with combining diaeresis
+	}
+
+	for _, test := range tests {
+		result := Length(test.input)
+		if result != test.expected {
+			t.Errorf("Length(%q) = %d, expected %d", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestGraphemeLength(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"hello", 5},
+		{"", 0},
+		{"你好", 2},
+		{"cafe\u0301", 4},     // Combining acute accent
+		{"a\u0308\u0301b", 2}, // Several combining marks
+		{"👍🏽", 1},             // Skin tone modifier
+		{"👨\u200d👩\u200d👧\u200d👦", 1}, // ZWJ family sequence
+		{"❤\ufe0f", 1}, // Variation selector
+		{"🇯🇵🇫🇷", 2},    // Regional indicator pairs
+		{"🇯🇵🇫", 2},     // Unpaired regional indicator
+		{"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", 1}, // Tag sequence
+		{"\r\n", 1},
+		{"\n\u0301", 2},           // Controls do not take marks
+		{"\u1100\u1161\u11A8", 1}, // Conjoining Hangul jamo
+		{"한국어", 3},
+	}
+
+	for _, test := range tests {
+		result := GraphemeLength(test.input)
+		if result != test.expected {
+			t.Errorf("GraphemeLength(%q) = %d, expected %d", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestGraphemes(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"abc", []string{"a", "b", "c"}},
+		{"e\u0301a", []string{"e\u0301", "a"}},
+		{"👍🏽!", []string{"👍🏽", "!"}},
+		{"hi 👨\u200d👩\u200d👧", []string{"h", "i", " ", "👨\u200d👩\u200d👧"}},
+		{"🇯🇵🇫🇷", []string{"🇯🇵", "🇫🇷"}},
+		{"a\u200db", []string{"a\u200d", "b"}},                 // ZWJ only joins pictographs
+		{"👩\u200db", []string{"👩\u200d", "b"}},                 // ZWJ only joins pictographs
+		{"👩\U0001F3FD\u200d💻", []string{"👩\U0001F3FD\u200d💻"}}, // Modifier before ZWJ
+		{"a\r\nb", []string{"a", "\r\n", "b"}},
+		{"", []string{}},
+	}
+
+	for _, test := range tests {
+		result := Graphemes(test.input)
+		if len(result) != len(test.expected) {
+			t.Errorf("Graphemes(%q) = %q, expected %q", test.input, result, test.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != test.expected[i] {
+				t.Errorf("Graphemes(%q)[%d] = %q, expected %q", test.input, i, result[i], test.expected[i])
+			}
+		}
+	}
+}
+
+func TestDisplayWidth(t *testing.T) {
 	tests := []struct {
 		input    string
-		maxWords int
-		expected string
+		expected int
 	}{
-		{"hello world", 1, "hello..."},
-		{"hello world", 2, "hello world"},
-		{"hello world", 3, "hello world"},
-		{"one two three four", 2, "one two..."},
-		{"one two three four", 0, ""},
-		{"", 5, ""},
-		{"   ", 5, ""},
-		{"one", 1, "one"},
-		{"one-two three", 1, "one-two..."}, // Hyphen is treated as part of the word
-		{"one_two three", 1, "one_two..."}, // Underscore is treated as part of the word
-		{"one.two three", 1, "one.two..."}, // Period is treated as part of the word
-		{"one,two three", 1, "one,two..."}, // Comma is treated as part of the word
+		{"abc", 3},
+		{"", 0},
+		{"你好", 4},
+		{"Go言語", 6},
+		{"こんにちは", 10},
+		{"한국어", 6},
+		{"ＡＢ", 4},       // Fullwidth Latin
+		{"ｱｲ", 2},       // Halfwidth katakana
+		{"e\u0301", 1},  // Combining mark
+		{"a\u200bb", 2}, // Zero width space
+		{"🎉", 2},
+		{"🚀", 2}, // U+1F680, Transport and Map Symbols
+		{"🫠", 2}, // U+1FAE0, Symbols and Pictographs Extended-A
+		{"☕", 2}, // U+2615, Miscellaneous Symbols
+		{"⌚", 2}, // U+231A, Miscellaneous Technical
+		{"✅", 2},
+		{"☺", 1}, // Text presentation by default
+		{"✓", 1},
+		{"🌡", 1}, // Text presentation by default
+		{"café 東京", 9},
 	}
 
 	for _, test := range tests {
-		result := TruncateWords(test.input, test.maxWords)
+		result := DisplayWidth(test.input)
 		if result != test.expected {
-			t.Errorf("TruncateWords(%q, %d) = %q, expected %q", test.input, test.maxWords, result, test.expected)
+			t.Errorf("DisplayWidth(%q) = %d, expected %d", test.input, result, test.expected)
 		}
 	}
 }
 
-func TestFormatWithCommas(t *testing.T) {
+func TestRemoveEmojis(t *testing.T) {
 	tests := []struct {
-		input    int64
+		input    string
 		expected string
 	}{
-		{1234, "1234"}, // Note: Current implementation doesn't add commas
-		{1234567, "1234567"},
-		{1234567890, "1234567890"},
-		{123, "123"},
-		{0, "0"},
-		{-1234, "-1234"},
-		{-1234567, "-1234567"},
-		{1, "1"},
-		{10, "10"},
-		{100, "100"},
-		{1000, "1000"},
+		{"Hello 👋 World", "Hello  World"},
+		{"john_doe🔥🔥", "john_doe"},
+		{"team👨‍👩‍👧", "team"},                      // ZWJ sequence
+		{"a👩‍💻b", "ab"},                            // ZWJ profession sequence
+		{"ok👍🏽", "ok"},                             // Skin tone modifier
+		{"I ❤️ Go", "I  Go"},                       // Variation selector
+		{"🇯🇵 Tokyo", " Tokyo"},                     // Flag
+		{"press 1️⃣", "press "},                    // Keycap
+		{"☕ and ✈", " and "},                       // Symbols and dingbats
+		{"✓ done ➜ next ❶ ✗", "✓ done ➜ next ❶ ✗"}, // Dingbats that are not pictographic
+		{"rated ★", "rated "},
+		{"café e\u0301", "café e\u0301"}, // Combining marks are kept
+		{"你好, мир", "你好, мир"},
+		{"© 2024 ™", "© 2024 ™"},
+		{"#1", "#1"},
+		{"", ""},
 	}
 
 	for _, test := range tests {
-		result := FormatWithCommas(test.input)
+		result := RemoveEmojis(test.input)
 		if result != test.expected {
-			t.Errorf("FormatWithCommas(%d) = %q, expected %q", test.input, result, test.expected)
+			t.Errorf("RemoveEmojis(%q) = %q, expected %q", test.input, result, test.expected)
 		}
 	}
 }
 
-func TestLength(t *testing.T) {
+func TestContainsEmoji(t *testing.T) {
 	tests := []struct {
 		input    string
-		expected int
+		expected bool
 	}{
-		{"hello", 5},
-		{"Hello, World!", 13},
-		{"", 0},
-		{"a", 1},
-		{"你好", 2},       // 2 Unicode characters
-		{"Hello 世界", 8}, // 5 ASCII + 2 Unicode characters + 1 space
-		{"café", 4},     // 4 characters, one with accent
-		{"café", 4},     // 4 characters, one with accent (different encoding)
-		{"a\u0308", 2},  // 'a' with combining diaeresis
+		{"Hello 👋", true},
+		{"👨‍👩‍👧", true},
+		{"🇫🇷", true},
+		{"#️⃣", true},
+		{"⭐", true},
+		{"Hello", false},
+		{"你好 é", false},
+		{"© ® ™", false},
+		{"#1 *", false},
+		{"✓", false},
+		{"➜", false},
+		{"❶ ✗", false},
+		{"☕", true},
+		{"", false},
 	}
 
 	for _, test := range tests {
-		result := Length(test.input)
+		result := ContainsEmoji(test.input)
 		if result != test.expected {
-			t.Errorf("Length(%q) = %d, expected %d", test.input, result, test.expected)
+			t.Errorf("ContainsEmoji(%q) = %v, expected %v", test.input, result, test.expected)
 		}
 	}
 }
@@ -1414,6 +2257,35 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestTruncateWith(t *testing.T) {
+	tests := []struct {
+		input    string
+		length   int
+		omission string
+		expected string
+	}{
+		{"héllo wörld", 5, "…", "héll…"},
+		{"Hello, World", 8, "...", "Hello..."},
+		{"你好世界", 3, "…", "你好…"},
+		{"Hello", 5, "...", "Hello"},
+		{"Hello", 10, "...", "Hello"},
+		{"Hello, World", 4, "", "Hell"},
+		{"Hello, World", 2, "...", ".."}, // Omission is cut to fit
+		{"Hello", 0, "...", ""},
+		{"", 3, "...", ""},
+	}
+
+	for _, test := range tests {
+		result := TruncateWith(test.input, test.length, test.omission)
+		if result != test.expected {
+			t.Errorf("TruncateWith(%q, %d, %q) = %q, expected %q", test.input, test.length, test.omission, result, test.expected)
+		}
+		if n := utf8.RuneCountInString(result); n > max(test.length, 0) {
+			t.Errorf("TruncateWith(%q, %d, %q) has %d runes, more than %d", test.input, test.length, test.omission, n, test.length)
+		}
+	}
+}
+
 func TestReplaceLast(t *testing.T) {
 	tests := []struct {
 		subject  string
@@ -1462,6 +2334,55 @@ func TestStart(t *testing.T) {
 	}
 }
 
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		input    string
+		before   string
+		after    []string
+		expected string
+	}{
+		{"value", "\"", nil, "\"value\""},
+		{"is", "This ", []string{" name?"}, "This is name?"},
+		{"", "*", nil, "**"},
+		{"", "(", []string{")"}, "()"},
+		{"value", "", nil, "value"},
+		{"value", "[", []string{""}, "[value"},
+	}
+
+	for _, test := range tests {
+		result := Wrap(test.input, test.before, test.after...)
+		if result != test.expected {
+			t.Errorf("Wrap(%q, %q, %q) = %q, expected %q", test.input, test.before, test.after, result, test.expected)
+		}
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	tests := []struct {
+		input    string
+		before   string
+		after    []string
+		expected string
+	}{
+		{"\"value\"", "\"", nil, "value"},
+		{"This is name?", "This ", []string{" name?"}, "is"},
+		{"**", "*", nil, ""},
+		{"()", "(", []string{")"}, ""},
+		{"\"value", "\"", nil, "\"value"},
+		{"value\"", "\"", nil, "value\""},
+		{"\"", "\"", nil, "\""}, // A lone delimiter cannot be both ends
+		{"[value]", "[", []string{"]"}, "value"},
+		{"", "*", nil, ""},
+	}
+
+	for _, test := range tests {
+		result := Unwrap(test.input, test.before, test.after...)
+		if result != test.expected {
+			t.Errorf("Unwrap(%q, %q, %q) = %q, expected %q", test.input, test.before, test.after, result, test.expected)
+		}
+	}
+}
+
 func TestStudly(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1667,6 +2588,62 @@ func TestPlural(t *testing.T) {
 	}
 }
 
+func TestRegisterInflections(t *testing.T) {
+	RegisterIrregular("cactus", "cacti")
+	RegisterUncountable("equipment")
+
+	tests := []struct {
+		fn       func(string) string
+		name     string
+		input    string
+		expected string
+	}{
+		{Plural, "Plural", "cactus", "cacti"},
+		{Plural, "Plural", "Cactus", "cacti"},
+		{Singular, "Singular", "cacti", "cactus"},
+		{Plural, "Plural", "equipment", "equipment"},
+		{Singular, "Singular", "equipment", "equipment"},
+		{Plural, "Plural", "book", "books"},
+	}
+
+	for _, test := range tests {
+		result := test.fn(test.input)
+		if result != test.expected {
+			t.Errorf("%s(%q) = %q, expected %q", test.name, test.input, result, test.expected)
+		}
+	}
+}
+
+func TestInflectionsConcurrentAccess(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			RegisterIrregular(fmt.Sprintf("thing%d", i), fmt.Sprintf("things%d", i))
+			RegisterUncountable(fmt.Sprintf("stuff%d", i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = Plural("child")
+			_ = Singular("children")
+		}()
+	}
+	wg.Wait()
+
+	for i := 0; i < 50; i++ {
+		singular := fmt.Sprintf("thing%d", i)
+		plural := fmt.Sprintf("things%d", i)
+		if result := Plural(singular); result != plural {
+			t.Errorf("Plural(%q) = %q, expected %q", singular, result, plural)
+		}
+		if result := Singular(plural); result != singular {
+			t.Errorf("Singular(%q) = %q, expected %q", plural, result, singular)
+		}
+	}
+}
+
 func TestSingular(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1710,6 +2687,38 @@ func TestSingular(t *testing.T) {
 	}
 }
 
+func TestPluralize(t *testing.T) {
+	tests := []struct {
+		word      string
+		count     int
+		inclusive []bool
+		expected  string
+	}{
+		{"apple", 1, nil, "apple"},
+		{"apple", 3, nil, "apples"},
+		{"apple", 0, nil, "apples"},
+		{"apple", -1, nil, "apple"}, // Negative counts use their absolute value
+		{"apple", -2, nil, "apples"},
+		{"child", 2, []bool{true}, "2 children"},
+		{"person", 1, []bool{true}, "1 person"},
+		{"apple", 0, []bool{true}, "0 apples"},
+		{"sheep", 5, []bool{true}, "5 sheep"},
+		{"box", 2, []bool{false}, "boxes"},
+		{"people", 1, []bool{true}, "1 person"}, // Irregular plurals are normalized
+		{"people", 3, []bool{true}, "3 people"},
+		{"children", 1, nil, "child"},
+		{"bus", 1, nil, "bus"}, // Singular words ending in "s" are kept
+		{"bus", 2, nil, "buses"},
+	}
+
+	for _, test := range tests {
+		result := Pluralize(test.word, test.count, test.inclusive...)
+		if result != test.expected {
+			t.Errorf("Pluralize(%q, %d, %v) = %q, expected %q", test.word, test.count, test.inclusive, result, test.expected)
+		}
+	}
+}
+
 func TestWordwrap(t *testing.T) {
 	tests := []struct {
 		input     string
@@ -1726,6 +2735,9 @@ func TestWordwrap(t *testing.T) {
 		{"The quick brown fox", 0, "\n", "The quick brown fox"},  // Width of 0 should not wrap
 		{"The quick brown fox", -1, "\n", "The quick brown fox"}, // Negative width should not wrap
 		{"Line1\nLine2\nLine3", 10, "\n", "Line1\nLine2\nLine3"}, // Preserve existing line breaks
+		{"你好 世界 你好 世界", 5, "\n", "你好 世界\n你好 世界"},                 // Width counts runes, not bytes
+		{"中华人民共和国", 3, "\n", "中华人\n民共和\n国"},
+		{"café crème brûlée", 10, "\n", "café crème\nbrûlée"},
 	}
 
 	for _, test := range tests {
@@ -1737,6 +2749,33 @@ func TestWordwrap(t *testing.T) {
 	}
 }
 
+func TestWordWrapIndent(t *testing.T) {
+	tests := []struct {
+		input         string
+		width         int
+		firstIndent   string
+		hangingIndent string
+		expected      string
+	}{
+		{"Show the help text for a command", 16, "  ", "      ", "  Show the help\n      text for a\n      command"},
+		{"short", 20, "- ", "  ", "- short"},
+		{"one two three four", 9, "", "  ", "one two\n  three\n  four"},
+		{"abcdefghij", 6, "> ", "> ", "> abcd\n> efgh\n> ij"}, // Long words are broken up
+		{"你好 世界 你好", 7, "  ", "  ", "  你好 世界\n  你好"},          // Width counts runes
+		{"a b", 0, "* ", "  ", "* a b"},                       // Non-positive width does not wrap
+		{"   ", 10, "* ", "  ", ""},
+		{"", 10, "* ", "  ", ""},
+	}
+
+	for _, test := range tests {
+		result := WordWrapIndent(test.input, test.width, test.firstIndent, test.hangingIndent)
+		if result != test.expected {
+			t.Errorf("WordWrapIndent(%q, %d, %q, %q) = %q, expected %q",
+				test.input, test.width, test.firstIndent, test.hangingIndent, result, test.expected)
+		}
+	}
+}
+
 func TestApa(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -1953,6 +2992,49 @@ func TestChopEnd(t *testing.T) {
 	}
 }
 
+func TestTrimPrefixRepeat(t *testing.T) {
+	tests := []struct {
+		input    string
+		prefix   string
+		expected string
+	}{
+		{"../../../etc/passwd", "../", "etc/passwd"},
+		{"0007", "0", "7"},
+		{"abab", "ab", ""},
+		{"aab", "ab", "aab"}, // Prefix must match at the start
+		{"hello", "", "hello"},
+		{"", "x", ""},
+	}
+
+	for _, test := range tests {
+		result := TrimPrefixRepeat(test.input, test.prefix)
+		if result != test.expected {
+			t.Errorf("TrimPrefixRepeat(%q, %q) = %q, expected %q", test.input, test.prefix, result, test.expected)
+		}
+	}
+}
+
+func TestTrimSuffixRepeat(t *testing.T) {
+	tests := []struct {
+		input    string
+		suffix   string
+		expected string
+	}{
+		{"path///", "/", "path"},
+		{"done!?!?", "!?", "done"},
+		{"file.tar.gz.gz", ".gz", "file.tar"},
+		{"hello", "", "hello"},
+		{"", "x", ""},
+	}
+
+	for _, test := range tests {
+		result := TrimSuffixRepeat(test.input, test.suffix)
+		if result != test.expected {
+			t.Errorf("TrimSuffixRepeat(%q, %q) = %q, expected %q", test.input, test.suffix, result, test.expected)
+		}
+	}
+}
+
 func TestExcerpt(t *testing.T) {
 	// Test with default options
 	defaultTests := []struct {
@@ -1985,11 +3067,14 @@ func TestExcerpt(t *testing.T) {
 		{"This is my name", "my", ExcerptOptions{Radius: 3}, "...is my na..."},
 		{"This is my name", "name", ExcerptOptions{Radius: 3}, "...my name"},
 		{"This is my name", "This", ExcerptOptions{Radius: 3}, "This is..."},
-		{"This is my name", "missing", ExcerptOptions{Radius: 3}, "This is my name"}, // Phrase not found
-		{"", "my", ExcerptOptions{Radius: 3}, ""},                                    // Empty string
-		{"This is my name", "", ExcerptOptions{Radius: 3}, "This is my name"},        // Empty phrase
-		{"This is my name", "my", ExcerptOptions{Radius: 0}, "...my..."},             // Zero radius
-		{"This is my name", "my", ExcerptOptions{Radius: 100}, "This is my name"},    // Large radius
+		{"This is my name", "missing", ExcerptOptions{Radius: 3}, "This is my name"},  // Phrase not found
+		{"", "my", ExcerptOptions{Radius: 3}, ""},                                     // Empty string
+		{"This is my name", "", ExcerptOptions{Radius: 3}, "This is my name"},         // Empty phrase
+		{"This is my name", "my", ExcerptOptions{Radius: 0}, "...my..."},              // Zero radius
+		{"This is my name", "my", ExcerptOptions{Radius: 100}, "This is my name"},     // Large radius
+		{"Voilà où est né Noël", "est", ExcerptOptions{Radius: 3}, "...où est né..."}, // Radius counts runes
+		{"Crème brûlée", "brûlée", ExcerptOptions{Radius: 2}, "...e brûlée"},          // Omission never splits a rune
+		{"日本語のテキスト", "の", ExcerptOptions{Radius: 1}, "...語のテ..."},
 	}
 
 	for _, test := range radiusTests {
@@ -2321,3 +3406,174 @@ func TestSwap(t *testing.T) {
 		}
 	}
 }
+
+func TestBoolOr(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      bool
+		expected bool
+	}{
+		{"true", false, true},
+		{" 0 ", true, false},
+		{"T", false, true},
+		{"yes", false, false},
+		{"", true, true},
+	}
+
+	for _, test := range tests {
+		result := BoolOr(test.input, test.def)
+		if result != test.expected {
+			t.Errorf("BoolOr(%q, %v) = %v, expected %v", test.input, test.def, result, test.expected)
+		}
+	}
+}
+
+func TestIntOr(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      int
+		expected int
+	}{
+		{"42", 0, 42},
+		{" -7 ", 0, -7},
+		{"4.2", 10, 10},
+		{"abc", 10, 10},
+		{"", 10, 10},
+	}
+
+	for _, test := range tests {
+		result := IntOr(test.input, test.def)
+		if result != test.expected {
+			t.Errorf("IntOr(%q, %d) = %d, expected %d", test.input, test.def, result, test.expected)
+		}
+	}
+}
+
+func TestFloatOr(t *testing.T) {
+	tests := []struct {
+		input    string
+		def      float64
+		expected float64
+	}{
+		{"3.14", 0, 3.14},
+		{"1e3", 0, 1000},
+		{" 2 ", 0, 2},
+		{"abc", 1.5, 1.5},
+		{"", 1.5, 1.5},
+	}
+
+	for _, test := range tests {
+		result := FloatOr(test.input, test.def)
+		if result != test.expected {
+			t.Errorf("FloatOr(%q, %v) = %v, expected %v", test.input, test.def, result, test.expected)
+		}
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected float64
+	}{
+		{"MARTHA", "MARHTA", 0.961111},
+		{"DWAYNE", "DUANE", 0.84},
+		{"DIXON", "DICKSONX", 0.813333},
+		{"JELLYFISH", "SMELLYFISH", 0.896296},
+		{"same", "same", 1},
+		{"abc", "xyz", 0},
+		{"", "", 1},
+		{"abc", "", 0},
+		{"José", "Jose", 0.883333}, // Compared by rune
+	}
+
+	for _, test := range tests {
+		result := JaroWinkler(test.a, test.b)
+		if math.Abs(result-test.expected) > 1e-6 {
+			t.Errorf("JaroWinkler(%q, %q) = %f, expected %f", test.a, test.b, result, test.expected)
+		}
+		if reverse := JaroWinkler(test.b, test.a); math.Abs(reverse-result) > 1e-9 {
+			t.Errorf("JaroWinkler(%q, %q) = %f, not symmetric with %f", test.b, test.a, reverse, result)
+		}
+	}
+}
+
+func TestSoundex(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Robert", "R163"},
+		{"Rupert", "R163"},
+		{"Rubin", "R150"},
+		{"Ashcraft", "A261"}, // H does not separate S and C
+		{"Ashcroft", "A261"},
+		{"Tymczak", "T522"},
+		{"Pfister", "P236"}, // First letter's code is not repeated
+		{"Honeyman", "H555"},
+		{"Lee", "L000"},
+		{"o'hara", "O600"},
+		{"123", ""},
+		{"", ""},
+	}
+
+	for _, test := range tests {
+		result := Soundex(test.input)
+		if result != test.expected {
+			t.Errorf("Soundex(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestSoundsLike(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{"Robert", "Rupert", true},
+		{"Smith", "Smyth", true},
+		{"smith", "SMITH", true},
+		{"Robert", "Rubin", false},
+		{"", "", false},
+	}
+
+	for _, test := range tests {
+		result := SoundsLike(test.a, test.b)
+		if result != test.expected {
+			t.Errorf("SoundsLike(%q, %q) = %v, expected %v", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func BenchmarkSlugify(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 100000; j++ {
+			_ = Slugify("Hello World! This is a Test")
+		}
+	}
+}
+
+func BenchmarkCamelCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = CamelCase("created_at_utc")
+	}
+}
+
+func BenchmarkSnakeToCamel(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = SnakeToCamel("created_at_utc")
+	}
+}
+
+func BenchmarkSnakeCase(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = SnakeCase("XMLHttpRequestCreatedAt")
+	}
+}
+
+func BenchmarkCamelToSnake(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = CamelToSnake("XMLHttpRequestCreatedAt")
+	}
+}